@@ -98,6 +98,7 @@ type (
 		Repositories  RepositoryService
 		Releases      ReleaseService
 		Reviews       ReviewService
+		Search        SearchService
 		Users         UserService
 		Webhooks      WebhookService
 
@@ -211,6 +212,14 @@ func (r *Response) populatePageValues() {
 
 		page := url.Query().Get("page")
 		if page == "" {
+			// cursor based pagination links do not include
+			// a page number, in which case the next link is
+			// returned as-is.
+			for _, segment := range segments[1:] {
+				if strings.TrimSpace(segment) == `rel="next"` {
+					r.Page.NextURL = url.String()
+				}
+			}
 			continue
 		}
 
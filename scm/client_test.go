@@ -39,3 +39,18 @@ func TestResponse(t *testing.T) {
 		t.Errorf("Want rel next %d, got %d", want, got)
 	}
 }
+
+func TestResponse_Cursor(t *testing.T) {
+	res := newResponse(&http.Response{
+		StatusCode: 200,
+		Header: http.Header{
+			"Link": {`<https://api.github.com/resource?per_page=2&cursor=v1_12077215967>; rel="next"`},
+		},
+	})
+	if got, want := res.Page.Next, 0; got != want {
+		t.Errorf("Want rel next %d, got %d", want, got)
+	}
+	if got, want := res.Page.NextURL, "https://api.github.com/resource?per_page=2&cursor=v1_12077215967"; got != want {
+		t.Errorf("Want rel next url %q, got %q", want, got)
+	}
+}
@@ -174,6 +174,31 @@ func (r Role) String() (s string) {
 	}
 }
 
+// UserType defines the user account type.
+type UserType int
+
+// UserType values.
+const (
+	UserTypeUndefined UserType = iota
+	UserTypeUser
+	UserTypeBot
+	UserTypeOrg
+)
+
+// String returns the string representation of UserType.
+func (t UserType) String() string {
+	switch t {
+	case UserTypeUser:
+		return "user"
+	case UserTypeBot:
+		return "bot"
+	case UserTypeOrg:
+		return "org"
+	default:
+		return "unknown"
+	}
+}
+
 // ContentKind defines the kind of a content in a directory.
 type ContentKind int
 
@@ -248,4 +273,73 @@ func (v Visibility) String() (s string) {
 	}
 }
 
+// SortBy identifies the field used to sort a list.
+type SortBy int
+
+// SortBy values.
+const (
+	SortByDefault SortBy = iota
+	SortByCreated
+	SortByUpdated
+)
+
+// String returns the string representation of SortBy.
+func (s SortBy) String() string {
+	switch s {
+	case SortByCreated:
+		return "created"
+	case SortByUpdated:
+		return "updated"
+	default:
+		return ""
+	}
+}
+
+// SortDirection defines the order of a sorted list.
+type SortDirection int
+
+// SortDirection values.
+const (
+	SortDirectionDefault SortDirection = iota
+	SortAsc
+	SortDesc
+)
+
+// String returns the string representation of SortDirection.
+func (d SortDirection) String() string {
+	switch d {
+	case SortAsc:
+		return "asc"
+	case SortDesc:
+		return "desc"
+	default:
+		return ""
+	}
+}
+
+// DiffFormat defines the format of a raw diff.
+type DiffFormat int
+
+// DiffFormat values.
+const (
+	// DiffFormatDiff is a unified diff.
+	DiffFormatDiff DiffFormat = iota
+	// DiffFormatPatch is a series of mbox formatted
+	// patches, one per commit, as produced by
+	// git format-patch.
+	DiffFormatPatch
+)
+
+// String returns the string representation of DiffFormat.
+func (f DiffFormat) String() string {
+	switch f {
+	case DiffFormatPatch:
+		return "patch"
+	default:
+		return "diff"
+	}
+}
+
+// SearchTimeFormat is the time format used when encoding
+// timestamps in search queries.
 const SearchTimeFormat = "2006-01-02T15:04:05Z"
@@ -0,0 +1,229 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrMalformedPatch is returned when the unified diff
+	// cannot be parsed.
+	ErrMalformedPatch = errors.New("Malformed patch")
+
+	// ErrDiffTooLarge is returned when reading a raw diff
+	// that exceeds the maximum size.
+	ErrDiffTooLarge = errors.New("Diff exceeds the maximum size")
+)
+
+// regular expression to extract the line ranges and the
+// optional section heading from a unified diff hunk header
+// (e.g. @@ -1,5 +1,6 @@ func main() {)
+var reHunk = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// LineKind identifies the type of a diff line.
+type LineKind int
+
+// LineKind values.
+const (
+	LineContext LineKind = iota
+	LineAdded
+	LineDeleted
+)
+
+// String returns the string representation of LineKind.
+func (k LineKind) String() string {
+	switch k {
+	case LineAdded:
+		return "added"
+	case LineDeleted:
+		return "deleted"
+	default:
+		return "context"
+	}
+}
+
+type (
+	// DiffOptions provides options for downloading a
+	// raw diff or patch.
+	DiffOptions struct {
+		Format DiffFormat
+
+		// MaxSize is the maximum number of bytes that can
+		// be read from the diff, after which reads return
+		// ErrDiffTooLarge. A zero value means no limit.
+		MaxSize int64
+	}
+
+	// Hunk represents a contiguous section of a unified
+	// diff, with the line ranges in the old and new file.
+	Hunk struct {
+		OldStart int
+		OldLines int
+		NewStart int
+		NewLines int
+		Section  string
+		Lines    []*DiffLine
+	}
+
+	// DiffLine represents a single line of a diff hunk.
+	// OldLine is zero for added lines, and NewLine is
+	// zero for deleted lines.
+	DiffLine struct {
+		Kind    LineKind
+		Text    string
+		OldLine int
+		NewLine int
+	}
+)
+
+// ParsePatch parses the unified diff of a single file and
+// returns the list of hunks. File headers preceding the
+// first hunk are ignored.
+func ParsePatch(patch string) ([]*Hunk, error) {
+	var hunks []*Hunk
+	var hunk *Hunk
+	var oldLine, newLine int
+
+	patch = strings.TrimSuffix(patch, "\n")
+	if patch == "" {
+		return hunks, nil
+	}
+	for _, line := range strings.Split(patch, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.HasPrefix(line, "@@") {
+			match := reHunk.FindStringSubmatch(line)
+			if match == nil {
+				return nil, ErrMalformedPatch
+			}
+			hunk = &Hunk{
+				OldStart: atoi(match[1], 0),
+				OldLines: atoi(match[2], 1),
+				NewStart: atoi(match[3], 0),
+				NewLines: atoi(match[4], 1),
+				Section:  match[5],
+			}
+			hunks = append(hunks, hunk)
+			oldLine = hunk.OldStart
+			newLine = hunk.NewStart
+			continue
+		}
+		if hunk == nil {
+			// skip the file headers (e.g. diff --git,
+			// index, --- and +++) before the first hunk.
+			continue
+		}
+		switch {
+		case strings.HasPrefix(line, "+"):
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Kind:    LineAdded,
+				Text:    line[1:],
+				NewLine: newLine,
+			})
+			newLine++
+		case strings.HasPrefix(line, "-"):
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Kind:    LineDeleted,
+				Text:    line[1:],
+				OldLine: oldLine,
+			})
+			oldLine++
+		case strings.HasPrefix(line, " "), line == "":
+			// some providers strip the leading space of
+			// empty context lines.
+			hunk.Lines = append(hunk.Lines, &DiffLine{
+				Kind:    LineContext,
+				Text:    strings.TrimPrefix(line, " "),
+				OldLine: oldLine,
+				NewLine: newLine,
+			})
+			oldLine++
+			newLine++
+		case strings.HasPrefix(line, "\\"):
+			// ignore the "\ No newline at end of file"
+			// marker.
+		default:
+			return nil, ErrMalformedPatch
+		}
+	}
+	return hunks, nil
+}
+
+// PatchStats returns the number of added and deleted lines
+// in the unified diff.
+func PatchStats(patch string) (additions, deletions int) {
+	hunks, _ := ParsePatch(patch)
+	for _, hunk := range hunks {
+		for _, line := range hunk.Lines {
+			switch line.Kind {
+			case LineAdded:
+				additions++
+			case LineDeleted:
+				deletions++
+			}
+		}
+	}
+	return
+}
+
+// IsBinaryPatch returns true if the unified diff reports
+// that the file is binary.
+func IsBinaryPatch(patch string) bool {
+	for _, line := range strings.Split(patch, "\n") {
+		if strings.HasPrefix(line, "@@") {
+			return false
+		}
+		if strings.HasPrefix(line, "Binary files ") ||
+			strings.HasPrefix(line, "GIT binary patch") {
+			return true
+		}
+	}
+	return false
+}
+
+// LimitReadCloser returns a ReadCloser that reads at most
+// n bytes from r, and returns ErrDiffTooLarge if r has more
+// data. If n is zero, r is returned unchanged.
+func LimitReadCloser(r io.ReadCloser, n int64) io.ReadCloser {
+	if n <= 0 {
+		return r
+	}
+	return &limitReadCloser{r, n}
+}
+
+type limitReadCloser struct {
+	io.ReadCloser
+	n int64
+}
+
+func (l *limitReadCloser) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		// read a single byte to determine if the
+		// underlying reader is exhausted.
+		n, err := l.ReadCloser.Read(make([]byte, 1))
+		if n > 0 {
+			return 0, ErrDiffTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.ReadCloser.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
+
+func atoi(s string, fallback int) int {
+	if s == "" {
+		return fallback
+	}
+	i, _ := strconv.Atoi(s)
+	return i
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch := `diff --git a/main.go b/main.go
+index 3f4a1c2..8b1d2e0 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,5 @@ package main
+ import "fmt"
+-func main() {
++
++func main() {
++	fmt.Println("hello")
+
+@@ -10 +11,0 @@ func helper() {
+-	return
+\ No newline at end of file
+`
+	got, err := ParsePatch(patch)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	want := []*Hunk{
+		{
+			OldStart: 1,
+			OldLines: 4,
+			NewStart: 1,
+			NewLines: 5,
+			Section:  "package main",
+			Lines: []*DiffLine{
+				{Kind: LineContext, Text: `import "fmt"`, OldLine: 1, NewLine: 1},
+				{Kind: LineDeleted, Text: "func main() {", OldLine: 2},
+				{Kind: LineAdded, Text: "", NewLine: 2},
+				{Kind: LineAdded, Text: "func main() {", NewLine: 3},
+				{Kind: LineAdded, Text: `	fmt.Println("hello")`, NewLine: 4},
+				{Kind: LineContext, Text: "", OldLine: 3, NewLine: 5},
+			},
+		},
+		{
+			OldStart: 10,
+			OldLines: 1,
+			NewStart: 11,
+			NewLines: 0,
+			Section:  "func helper() {",
+			Lines: []*DiffLine{
+				{Kind: LineDeleted, Text: "	return", OldLine: 10},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestParsePatch_Malformed(t *testing.T) {
+	tests := []string{
+		"@@ -1,2 +1,2\n foo",
+		"@@ -1 +1 @@\n*foo",
+	}
+	for _, test := range tests {
+		if _, err := ParsePatch(test); err != ErrMalformedPatch {
+			t.Errorf("Expect malformed patch error for %q", test)
+		}
+	}
+}
+
+func TestPatchStats(t *testing.T) {
+	additions, deletions := PatchStats("@@ -1,2 +1,3 @@\n-foo\n+bar\n+baz\n qux\n")
+	if got, want := additions, 2; got != want {
+		t.Errorf("Want %d additions, got %d", want, got)
+	}
+	if got, want := deletions, 1; got != want {
+		t.Errorf("Want %d deletions, got %d", want, got)
+	}
+}
+
+func TestIsBinaryPatch(t *testing.T) {
+	tests := []struct {
+		patch  string
+		binary bool
+	}{
+		{"Binary files a/logo.png and b/logo.png differ\n", true},
+		{"diff --git a/logo.png b/logo.png\nGIT binary patch\nliteral 0\n", true},
+		{"@@ -1 +1 @@\n-Binary files\n+foo\n", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if got, want := IsBinaryPatch(test.patch), test.binary; got != want {
+			t.Errorf("Want binary %v for %q, got %v", want, test.patch, got)
+		}
+	}
+}
@@ -40,6 +40,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -141,6 +142,33 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream sends a GET request and returns the response body
+// without reading it. The caller must close the returned
+// reader.
+func (c *wrapper) stream(ctx context.Context, path string, limit int64) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status == 401 {
+		res.Body.Close()
+		return nil, res, scm.ErrNotAuthorized
+	} else if res.Status > 300 {
+		defer res.Body.Close()
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		return nil, res, err
+	}
+	return scm.LimitReadCloser(res.Body, limit), res, nil
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
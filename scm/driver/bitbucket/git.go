@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -61,7 +62,7 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 	out := new(commits)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
-	return convertCommitList(out), res, err
+	return filterCommits(convertCommitList(out), opts), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -88,6 +89,16 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/%s/%s", repo, opts.Format, ref)
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/%s/%s..%s", repo, opts.Format, source, target)
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
 type branch struct {
 	Type   string `json:"type"`
 	Name   string `json:"name"`
@@ -210,12 +221,21 @@ func convertDiffstats(from *diffstats) []*scm.Change {
 }
 
 func convertDiffstat(from *diffstat) *scm.Change {
-	return &scm.Change{
-		Path:    from.New.Path,
-		Added:   from.Status == "added",
-		Renamed: from.Status == "renamed",
-		Deleted: from.Status == "removed",
+	to := &scm.Change{
+		Path:      from.New.Path,
+		Added:     from.Status == "added",
+		Renamed:   from.Status == "renamed",
+		Deleted:   from.Status == "removed",
+		Additions: from.LinesAdded,
+		Deletions: from.LinesRemoved,
+	}
+	if to.Path == "" {
+		to.Path = from.Old.Path
+	}
+	if to.Renamed {
+		to.PrevPath = from.Old.Path
 	}
+	return to
 }
 
 func convertCommitList(from *commits) []*scm.Commit {
@@ -226,6 +246,28 @@ func convertCommitList(from *commits) []*scm.Commit {
 	return to
 }
 
+// filterCommits applies the commit list filters that
+// cannot be expressed as bitbucket query parameters.
+func filterCommits(from []*scm.Commit, opts scm.CommitListOptions) []*scm.Commit {
+	to := []*scm.Commit{}
+	for _, v := range from {
+		author := v.Author
+		switch {
+		case opts.Author != "" &&
+			opts.Author != author.Login &&
+			opts.Author != author.Name &&
+			opts.Author != author.Email:
+			continue
+		case !opts.Since.IsZero() && author.Date.Before(opts.Since):
+			continue
+		case !opts.Until.IsZero() && author.Date.After(opts.Until):
+			continue
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
 func convertCommit(from *commit) *scm.Commit {
 	return &scm.Commit{
 		Message: from.Message,
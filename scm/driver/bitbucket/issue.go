@@ -6,6 +6,8 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,41 +17,224 @@ type issueService struct {
 }
 
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssue(out), res, err
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, index, id)
+	out := new(comment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertComment(out), res, err
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues?%s", repo, encodeIssueListOptions(opts))
+	out := new(issues)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertIssueList(out), res, err
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments?%s", repo, index, encodeListOptions(opts))
+	out := new(comments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCommentList(out), res, err
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues", repo)
+	in := new(issueInput)
+	in.Title = input.Title
+	in.Content.Raw = input.Body
+	out := new(issue)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertIssue(out), res, err
 }
 
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments", repo, number)
+	in := new(commentInput)
+	in.Content.Raw = input.Body
+	out := new(comment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertComment(out), res, err
 }
 
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	in := &issueStateInput{State: "closed"}
+	return s.client.do(ctx, "PUT", path, in, nil)
 }
 
+// Lock is not supported. Bitbucket Cloud issues cannot be
+// locked.
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// Unlock is not supported. Bitbucket Cloud issues cannot be
+// locked.
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+//
+// native data structures
+//
+
+type (
+	// bitbucket issue object.
+	issue struct {
+		ID       int    `json:"id"`
+		Title    string `json:"title"`
+		State    string `json:"state"`
+		Kind     string `json:"kind"`
+		Priority string `json:"priority"`
+		Content  struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+		Reporter user `json:"reporter"`
+		Links    struct {
+			HTML link `json:"html"`
+		} `json:"links"`
+		CreatedOn time.Time `json:"created_on"`
+		UpdatedOn time.Time `json:"updated_on"`
+	}
+
+	// bitbucket issue list object.
+	issues struct {
+		pagination
+		Values []*issue `json:"values"`
+	}
+
+	// bitbucket issue request object.
+	issueInput struct {
+		Title   string `json:"title"`
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+	}
+
+	// bitbucket issue state request object.
+	issueStateInput struct {
+		State string `json:"state"`
+	}
+
+	// bitbucket issue and pull request comment object. The
+	// inline field is only set for pull request comments
+	// that are attached to a file.
+	comment struct {
+		ID      int `json:"id"`
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+		User   user    `json:"user"`
+		Inline *inline `json:"inline"`
+		Links  struct {
+			HTML link `json:"html"`
+		} `json:"links"`
+		CreatedOn time.Time `json:"created_on"`
+		UpdatedOn time.Time `json:"updated_on"`
+	}
+
+	// bitbucket comment list object.
+	comments struct {
+		pagination
+		Values []*comment `json:"values"`
+	}
+
+	// bitbucket inline comment location. The to field is
+	// the line number in the new version of the file, and
+	// the from field is the line number in the old version.
+	inline struct {
+		Path string `json:"path"`
+		From *int   `json:"from,omitempty"`
+		To   *int   `json:"to,omitempty"`
+	}
+
+	// bitbucket comment request object.
+	commentInput struct {
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+		Inline *inline `json:"inline,omitempty"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertIssueList(from *issues) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from.Values {
+		to = append(to, convertIssue(v))
+	}
+	return to
+}
+
+func convertIssue(from *issue) *scm.Issue {
+	return &scm.Issue{
+		Number:  from.ID,
+		Title:   from.Title,
+		Body:    from.Content.Raw,
+		Link:    from.Links.HTML.Href,
+		Closed:  isIssueClosed(from.State),
+		Author:  convertAuthor(&from.Reporter),
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+// isIssueClosed returns true if the issue state is one
+// of the resolution states of the Bitbucket issue tracker.
+func isIssueClosed(state string) bool {
+	switch state {
+	case "resolved", "invalid", "duplicate", "wontfix", "closed":
+		return true
+	default:
+		return false
+	}
+}
+
+func convertCommentList(from *comments) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from.Values {
+		to = append(to, convertComment(v))
+	}
+	return to
+}
+
+func convertComment(from *comment) *scm.Comment {
+	return &scm.Comment{
+		ID:      from.ID,
+		Body:    from.Content.Raw,
+		Author:  convertAuthor(&from.User),
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+// convertAuthor converts the issue, pull request or
+// comment author. The nickname is used as the login since
+// the username is no longer returned by the api.
+func convertAuthor(from *user) scm.User {
+	return scm.User{
+		ID:     from.UUID,
+		Login:  from.Nickname,
+		Name:   from.DisplayName,
+		Avatar: from.Links.Avatar.Href,
+		Link:   from.Links.HTML.Href,
+		Type:   convertUserType(from.Type),
+	}
+}
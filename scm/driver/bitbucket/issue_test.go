@@ -6,64 +6,263 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestIssueFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.Find(context.Background(), "", 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Find(context.Background(), "jcitizen/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCommentFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.FindComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues/1/comments/58012544").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.FindComment(context.Background(), "jcitizen/hello-world", 1, 58012544)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueList(t *testing.T) {
-	_, _, err := NewDefault().Issues.List(context.Background(), "", scm.IssueListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.List(context.Background(), "jcitizen/hello-world", scm.IssueListOptions{Page: 1, Size: 10, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueList_Open(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues").
+		MatchParam("q", `^\(state!="resolved" AND state!="invalid" AND state!="duplicate" AND state!="wontfix" AND state!="closed"\)$`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues_open.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.List(context.Background(), "jcitizen/hello-world", scm.IssueListOptions{Open: true})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues_open.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueList_Closed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues").
+		MatchParam("q", `^\(state="resolved" OR state="invalid" OR state="duplicate" OR state="wontfix" OR state="closed"\)$`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Issues.List(context.Background(), "jcitizen/hello-world", scm.IssueListOptions{Closed: true})
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestIssueListComments(t *testing.T) {
-	_, _, err := NewDefault().Issues.ListComments(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/jcitizen/hello-world/issues/1/comments").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.ListComments(context.Background(), "jcitizen/hello-world", 1, scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/issue_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCreate(t *testing.T) {
-	_, _, err := NewDefault().Issues.Create(context.Background(), "", &scm.IssueInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/jcitizen/hello-world/issues").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"title":   "Found a bug",
+			"content": map[string]string{"raw": "I'm having a problem with this."},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Create(context.Background(), "jcitizen/hello-world", &scm.IssueInput{Title: "Found a bug", Body: "I'm having a problem with this."})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestIssueCreateComment(t *testing.T) {
-	_, _, err := NewDefault().Issues.CreateComment(context.Background(), "", 0, &scm.CommentInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/jcitizen/hello-world/issues/1/comments").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Can you share the stack trace?"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.CreateComment(context.Background(), "jcitizen/hello-world", 1, &scm.CommentInput{Body: "Can you share the stack trace?"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestIssueCommentDelete(t *testing.T) {
-	_, err := NewDefault().Issues.DeleteComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/jcitizen/hello-world/issues/1/comments/58012544").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.DeleteComment(context.Background(), "jcitizen/hello-world", 1, 58012544)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestIssueClose(t *testing.T) {
-	_, err := NewDefault().Issues.Close(context.Background(), "", 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/jcitizen/hello-world/issues/1").
+		MatchType("json").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.Close(context.Background(), "jcitizen/hello-world", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
@@ -61,3 +61,56 @@ func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Refer
 
 	return fmt.Sprintf("%s%s/compare/%s%%0D%s", l.base, repo, s, t), nil
 }
+
+// File returns a link to the file.
+func (l *linker) File(ctx context.Context, repo string, ref scm.Reference, path string, lines scm.LineRange) (string, error) {
+	return fmt.Sprintf("%s%s/src/%s/%s%s", l.base, repo, refName(ref), path, lineAnchor(lines)), nil
+}
+
+// Blame returns a link to the blame view of the file.
+func (l *linker) Blame(ctx context.Context, repo string, ref scm.Reference, path string) (string, error) {
+	return fmt.Sprintf("%s%s/annotate/%s/%s", l.base, repo, refName(ref), path), nil
+}
+
+// Issue returns a link to the issue.
+func (l *linker) Issue(ctx context.Context, repo string, number int) (string, error) {
+	return fmt.Sprintf("%s%s/issues/%d", l.base, repo, number), nil
+}
+
+// PullRequestComment returns a link to the pull request comment.
+func (l *linker) PullRequestComment(ctx context.Context, repo string, number, id int) (string, error) {
+	return fmt.Sprintf("%s%s/pull-requests/%d#comment-%d", l.base, repo, number, id), nil
+}
+
+// Release is not supported. Bitbucket does not support
+// releases.
+func (l *linker) Release(ctx context.Context, repo, tag string) (string, error) {
+	return "", scm.ErrNotSupported
+}
+
+// User returns a link to the user profile.
+func (l *linker) User(ctx context.Context, login string) (string, error) {
+	return fmt.Sprintf("%s%s/", l.base, login), nil
+}
+
+// refName returns the commit sha of the reference, falling
+// back to the short reference name.
+func refName(ref scm.Reference) string {
+	if ref.Sha != "" {
+		return ref.Sha
+	}
+	return scm.TrimRef(ref.Path)
+}
+
+// lineAnchor returns the url fragment that highlights the
+// range of lines.
+func lineAnchor(lines scm.LineRange) string {
+	switch {
+	case lines.Start == 0:
+		return ""
+	case lines.End == 0 || lines.End == lines.Start:
+		return fmt.Sprintf("#lines-%d", lines.Start)
+	default:
+		return fmt.Sprintf("#lines-%d:%d", lines.Start, lines.End)
+	}
+}
@@ -104,3 +104,67 @@ func TestDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		link func(context.Context, scm.Linker) (string, error)
+		want string
+	}{
+		{
+			name: "File",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.File(ctx, "octocat/hello-world", scm.Reference{Path: "refs/heads/master", Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"}, "README.md", scm.LineRange{Start: 10, End: 20})
+			},
+			want: "https://bitbucket.org/octocat/hello-world/src/a7389057b0eb027e73b32a81e3c5923a71d01dde/README.md#lines-10:20",
+		},
+		{
+			name: "Blame",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Blame(ctx, "octocat/hello-world", scm.Reference{Path: "refs/heads/master"}, "README.md")
+			},
+			want: "https://bitbucket.org/octocat/hello-world/annotate/master/README.md",
+		},
+		{
+			name: "Issue",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Issue(ctx, "octocat/hello-world", 42)
+			},
+			want: "https://bitbucket.org/octocat/hello-world/issues/42",
+		},
+		{
+			name: "PullRequestComment",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.PullRequestComment(ctx, "octocat/hello-world", 42, 1)
+			},
+			want: "https://bitbucket.org/octocat/hello-world/pull-requests/42#comment-1",
+		},
+		{
+			name: "User",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.User(ctx, "octocat")
+			},
+			want: "https://bitbucket.org/octocat/",
+		},
+	}
+
+	client := NewDefault()
+	for _, test := range tests {
+		got, err := test.link(context.Background(), client.Linker)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if want := test.want; got != want {
+			t.Errorf("%s: want link %q, got %q", test.name, want, got)
+		}
+	}
+}
+
+func TestLinksNotSupported(t *testing.T) {
+	client := NewDefault()
+	ctx, l := context.Background(), client.Linker
+	if _, err := l.Release(ctx, "octocat/hello-world", "v1.0.0"); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for Release")
+	}
+}
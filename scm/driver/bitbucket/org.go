@@ -34,6 +34,103 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+// ListMembers returns the workspace members and their roles,
+// where the name is the workspace slug.
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/permissions?%s", name, encodeListOptions(opts))
+	out := new(workspaceMemberships)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertMemberList(out), res, err
+}
+
+// ListTeams is not supported. Bitbucket Cloud workspaces do
+// not expose user groups in the 2.0 api.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListTeamMembers is not supported. Bitbucket Cloud workspaces
+// do not expose user groups in the 2.0 api.
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s?%s", name, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(repositories)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertRepositoryList(out), res, err
+}
+
+// AddMember is not supported. Bitbucket Cloud users join a
+// workspace by invitation, which the api does not provide.
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RemoveMember is not supported. The Bitbucket Cloud api does
+// not provide an endpoint to remove workspace members.
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// FindHook returns a workspace webhook, where the name is
+// the workspace slug.
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+// ListHooks returns the workspace webhooks.
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks?%s", name, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(hooks)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertHookList(out), res, err
+}
+
+// CreateHook creates a workspace webhook, which receives
+// events for all repositories in the workspace.
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks", name)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertHook(out), res, err
+}
+
+// UpdateHook updates a workspace webhook.
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertHook(out), res, err
+}
+
+// DeleteHook deletes a workspace webhook.
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/workspaces/%s/hooks/%s", name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func convertOrganizationList(from *organizationList) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from.Values {
@@ -47,6 +144,16 @@ type organizationList struct {
 	Values []*organization `json:"values"`
 }
 
+type workspaceMemberships struct {
+	pagination
+	Values []*workspaceMembership `json:"values"`
+}
+
+type workspaceMembership struct {
+	Permission string `json:"permission"`
+	User       *user  `json:"user"`
+}
+
 type organization struct {
 	Login string `json:"username"`
 }
@@ -57,3 +164,18 @@ func convertOrganization(from *organization) *scm.Organization {
 		Avatar: fmt.Sprintf("https://bitbucket.org/account/%s/avatar/32/", from.Login),
 	}
 }
+
+func convertMemberList(from *workspaceMemberships) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from.Values {
+		member := &scm.Member{
+			User: convertAuthor(v.User),
+			Role: scm.RoleMember,
+		}
+		if v.Permission == "owner" {
+			member.Role = scm.RoleAdmin
+		}
+		to = append(to, member)
+	}
+	return to
+}
@@ -67,3 +67,214 @@ func TestOrganizationList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrganizationListMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/permissions").
+		MatchParam("pagelen", "30").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workspace_permissions.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.ListMembers(context.Background(), "atlassian", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/workspace_permissions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeams(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Organizations.ListTeams(context.Background(), "atlassian", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Organizations.ListTeamMembers(context.Background(), "atlassian", "developers", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian").
+		MatchParam("pagelen", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Organizations.ListRepositories(context.Background(), "atlassian", scm.ListOptions{Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/workspace_repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.NextURL, "https://api.bitbucket.org/2.0/repositories?pagelen=1&after=PLACEHOLDER&role=member"; got != want {
+		t.Errorf("Want next page url %q, got %q", want, got)
+	}
+}
+
+func TestOrganizationAddMember(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Organizations.AddMember(context.Background(), "atlassian", "jcitizen", scm.RoleMember)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationRemoveMember(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.RemoveMember(context.Background(), "atlassian", "jcitizen")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationHookFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/hooks/{d53603cc-3f67-45ea-b310-aaa5ef6ec061}").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.FindHook(context.Background(), "atlassian", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/hooks").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "atlassian", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/workspaces/atlassian/hooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "atlassian", &scm.HookInput{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/workspaces/atlassian/hooks/{d53603cc-3f67-45ea-b310-aaa5ef6ec061}").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "atlassian", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}", &scm.HookInput{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/workspaces/atlassian/hooks/{d53603cc-3f67-45ea-b310-aaa5ef6ec061}").
+		Reply(204).Done()
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Organizations.DeleteHook(context.Background(), "atlassian", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}")
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverBitbucket, ParseURL)
+}
+
+// ParseURL parses a Bitbucket Cloud web or api url into a
+// resource. Only urls on bitbucket.org are recognized.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch u.Hostname() {
+	case "api.bitbucket.org":
+		if len(parts) > 1 && parts[0] == "2.0" && parts[1] == "repositories" {
+			return parseAPI(u, parts[2:])
+		}
+		return nil, scm.ErrUnknownURL
+	case "bitbucket.org":
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverBitbucket,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"),
+	}
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pull-requests":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) >= 2 && (rest[0] == "src" || rest[0] == "annotate"):
+		res.Kind = scm.ResourceKindTree
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+		// the web url does not distinguish files from
+		// directories, however a line anchor is only
+		// valid for files.
+		if res.Lines = scm.ParseLineRange(u.Fragment); res.Lines.Start != 0 || rest[0] == "annotate" {
+			res.Kind = scm.ResourceKindBlob
+		}
+	case len(rest) >= 2 && rest[0] == "branch":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = strings.Join(rest[1:], "/")
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// parseAPI parses the path segments of a Bitbucket api
+// url that follow the 2.0/repositories/ prefix.
+func parseAPI(u *url.URL, parts []string) (*scm.Resource, error) {
+	if len(parts) < 2 {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverBitbucket,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + parts[1],
+	}
+	var err error
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pullrequests":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commit":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) > 2 && rest[0] == "src":
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+	case len(rest) == 3 && rest[0] == "refs" && rest[1] == "tags":
+		res.Kind = scm.ResourceKindTag
+		res.Ref = rest[2]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/pull-requests/42/diff",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/issues/1/crash-on-start",
+			want: scm.Resource{Kind: scm.ResourceKindIssue, Number: 1},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "a6e5e7d797edf751cbd839d6bd4aef86c941eec9"},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/src/master/docs",
+			want: scm.Resource{Kind: scm.ResourceKindTree, Ref: "master", Path: "docs"},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/src/master/README.md#lines-10:20",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://bitbucket.org/atlassian/atlaskit/branch/feature/foo",
+			want: scm.Resource{Kind: scm.ResourceKindTree, Ref: "feature/foo"},
+		},
+		{
+			url:  "https://api.bitbucket.org/2.0/repositories/atlassian/atlaskit/pullrequests/42",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://api.bitbucket.org/2.0/repositories/atlassian/atlaskit/refs/tags/v1.0.0",
+			want: scm.Resource{Kind: scm.ResourceKindTag, Ref: "v1.0.0"},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverBitbucket
+		want.Host = got.Host
+		want.Repo = "atlassian/atlaskit"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://github.com/atlassian/atlaskit",
+		"https://bitbucket.org/atlassian",
+		"https://bitbucket.org/atlassian/atlaskit/pull-requests/foo",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -39,6 +40,11 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertDiffstats(out), res, err
 }
 
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/%s", repo, number, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
 func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/commits?%s", repo, number, encodeListOptions(opts))
 	out := new(commits)
@@ -53,8 +59,41 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 	return res, err
 }
 
+// Close declines the pull request. Bitbucket Cloud does not
+// support reopening a declined pull request.
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/decline", repo, number)
+	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	return res, err
+}
+
+func (s *pullService) FindComment(ctx context.Context, repo string, number, id int) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	out := new(comment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertComment(out), res, err
+}
+
+func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := new(comments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertCommentList(out), res, err
+}
+
+func (s *pullService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := new(commentInput)
+	in.Content.Raw = input.Body
+	out := new(comment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertComment(out), res, err
+}
+
+func (s *pullService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
@@ -166,11 +205,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 			Path: scm.ExpandRef(from.Destination.Branch.Name, "refs/heads"),
 			Sha:  from.Destination.Commit.Hash,
 		},
-		Author: scm.User{
-			Login:  from.Author.Nickname,
-			Name:   from.Author.DisplayName,
-			Avatar: from.Author.Links.Avatar.Href,
-		},
+		Author:  convertAuthor(&from.Author),
 		Created: from.CreatedOn,
 		Updated: from.UpdatedOn,
 	}
@@ -19,12 +19,18 @@ type cloneLink struct {
 }
 
 type repository struct {
-	UUID       string    `json:"uuid"`
-	SCM        string    `json:"scm"`
-	FullName   string    `json:"full_name"`
-	IsPrivate  bool      `json:"is_private"`
-	CreatedOn  time.Time `json:"created_on"`
-	UpdatedOn  time.Time `json:"updated_on"`
+	UUID        string    `json:"uuid"`
+	SCM         string    `json:"scm"`
+	FullName    string    `json:"full_name"`
+	IsPrivate   bool      `json:"is_private"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Description string    `json:"description"`
+	Language    string    `json:"language"`
+	Size        int64     `json:"size"`
+	Parent      *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
 	Mainbranch struct {
 		Type string `json:"type"`
 		Name string `json:"name"`
@@ -123,26 +129,31 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 	return convertStatusList(out), res, err
 }
 
+// ListTopics is not supported. Bitbucket Cloud
+// repositories do not have topics.
+func (s *repositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListLanguages is not supported. Bitbucket Cloud only
+// reports the primary repository language.
+func (s *repositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ReplaceTopics is not supported. Bitbucket Cloud
+// repositories do not have topics.
+func (s *repositoryService) ReplaceTopics(ctx context.Context, repo string, topics []string) ([]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 // CreateHook creates a new repository webhook.
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("2.0/repositories/%s/hooks", repo)
-	in := new(hookInput)
-	in.URL = target.String()
-	in.SkipCertVerification = input.SkipVerify
-	in.Active = true
-	in.Description = input.Name
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -165,24 +176,11 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 
 // UpdateHook updates a repository webhook.
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("2.0/repositories/%s/hooks/%s", repo, id)
-	in := new(hookInput)
-	in.URL = target.String()
-	in.SkipCertVerification = input.SkipVerify
-	in.Active = true
-	in.Description = input.Name
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PUT", path, in, out)
 	return convertHook(out), res, err
@@ -194,6 +192,30 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries is not supported. The Bitbucket Cloud
+// api does not expose the webhook request history.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindHookDelivery is not supported. The Bitbucket Cloud
+// api does not expose the webhook request history.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. The Bitbucket Cloud api
+// does not expose the webhook request history.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// PingHook is not supported. The Bitbucket Cloud api does
+// not provide an endpoint to test a webhook.
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -208,18 +230,26 @@ func convertRepositoryList(from *repositories) []*scm.Repository {
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
 	namespace, name := scm.Split(from.FullName)
-	return &scm.Repository{
-		ID:        from.UUID,
-		Name:      name,
-		Namespace: namespace,
-		Link:      from.Links.HTML.Href,
-		Branch:    from.Mainbranch.Name,
-		Private:   from.IsPrivate,
-		CloneSSH:  extractCloneLink(from.Links.Clone, "ssh"),
-		Clone:     anonymizeLink(extractCloneLink(from.Links.Clone, "https", "http")),
-		Created:   from.CreatedOn,
-		Updated:   from.UpdatedOn,
+	to := &scm.Repository{
+		ID:          from.UUID,
+		Name:        name,
+		Namespace:   namespace,
+		Link:        from.Links.HTML.Href,
+		Branch:      from.Mainbranch.Name,
+		Private:     from.IsPrivate,
+		CloneSSH:    extractCloneLink(from.Links.Clone, "ssh"),
+		Clone:       anonymizeLink(extractCloneLink(from.Links.Clone, "https", "http")),
+		Created:     from.CreatedOn,
+		Updated:     from.UpdatedOn,
+		Description: from.Description,
+		Language:    from.Language,
+		Size:        from.Size / 1024,
+	}
+	if from.Parent != nil {
+		to.Fork = true
+		to.Parent = from.Parent.FullName
 	}
+	return to
 }
 
 func extractCloneLink(links []cloneLink, names ...string) (href string) {
@@ -280,6 +310,30 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+// helper function to convert from the common hook input
+// structure to the bitbucket hook input structure. It is
+// shared by the repository and workspace hook endpoints.
+func convertFromHookInput(from *scm.HookInput) (*hookInput, error) {
+	target, err := url.Parse(from.Target)
+	if err != nil {
+		return nil, err
+	}
+	params := target.Query()
+	params.Set("secret", from.Secret)
+	target.RawQuery = params.Encode()
+
+	to := new(hookInput)
+	to.URL = target.String()
+	to.SkipCertVerification = from.SkipVerify
+	to.Active = true
+	to.Description = from.Name
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to, nil
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -306,6 +360,23 @@ func convertFromHookEvents(from scm.HookEvents) []string {
 	if from.IssueComment {
 		events = append(events, "issue:comment_created")
 	}
+	if from.PullRequestReview {
+		// approvals are already included in the pull
+		// request events.
+		if !from.PullRequest {
+			events = append(events, "pullrequest:approved")
+			events = append(events, "pullrequest:unapproved")
+		}
+		events = append(events, "pullrequest:changes_request_created")
+		events = append(events, "pullrequest:changes_request_removed")
+	}
+	if from.Status {
+		events = append(events, "repo:commit_status_created")
+		events = append(events, "repo:commit_status_updated")
+	}
+	if from.Repository {
+		events = append(events, "repo:updated")
+	}
 	return events
 }
 
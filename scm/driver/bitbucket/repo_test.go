@@ -424,3 +424,69 @@ func TestConvertPerms(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryTopicList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListTopics(context.Background(), "atlassian/stash-example-plugin")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryTopicReplace(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ReplaceTopics(context.Background(), "atlassian/stash-example-plugin", []string{"java"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryLanguageList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListLanguages(context.Background(), "atlassian/stash-example-plugin")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListHookDeliveries(context.Background(), "atlassian/stash-example-plugin", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	_, err := NewDefault().Repositories.PingHook(context.Background(), "atlassian/stash-example-plugin", "{d53603cc-3f67-45ea-b310-aaa5ef6ec061}")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestHookEvents(t *testing.T) {
+	tests := []struct {
+		in  scm.HookEvents
+		out []string
+	}{
+		{
+			in:  scm.HookEvents{Push: true},
+			out: []string{"repo:push"},
+		},
+		{
+			in:  scm.HookEvents{PullRequestReview: true},
+			out: []string{"pullrequest:approved", "pullrequest:unapproved", "pullrequest:changes_request_created", "pullrequest:changes_request_removed"},
+		},
+		{
+			in:  scm.HookEvents{Status: true},
+			out: []string{"repo:commit_status_created", "repo:commit_status_updated"},
+		},
+		{
+			in:  scm.HookEvents{Repository: true},
+			out: []string{"repo:updated"},
+		},
+	}
+	for i, test := range tests {
+		got, want := convertFromHookEvents(test.in), test.out
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
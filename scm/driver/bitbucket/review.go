@@ -6,26 +6,86 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// reviewService implements the review service using the
+// inline pull request comments of Bitbucket Cloud, which
+// does not provide a separate review api.
 type reviewService struct {
 	client *wrapper
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	out := new(comment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReview(out), res, err
 }
 
+// List returns the inline comments of the pull request.
+// Comments that are not attached to a file are excluded,
+// so a page may contain fewer results than requested.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := new(comments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertReviewList(out), res, err
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments", repo, number)
+	in := new(commentInput)
+	in.Content.Raw = input.Body
+	if input.Path != "" {
+		in.Inline = &inline{Path: input.Path}
+		if input.Line != 0 {
+			line := input.Line
+			in.Inline.To = &line
+		}
+	}
+	out := new(comment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+//
+// native data structure conversion
+//
+
+func convertReviewList(from *comments) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from.Values {
+		if v.Inline == nil {
+			continue
+		}
+		to = append(to, convertReview(v))
+	}
+	return to
+}
+
+func convertReview(from *comment) *scm.Review {
+	to := &scm.Review{
+		ID:      from.ID,
+		Body:    from.Content.Raw,
+		Link:    from.Links.HTML.Href,
+		Author:  convertAuthor(&from.User),
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+	if from.Inline != nil {
+		to.Path = from.Inline.Path
+		if from.Inline.To != nil {
+			to.Line = *from.Inline.To
+		}
+	}
+	return to
 }
@@ -6,35 +6,113 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/114733788").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Find(context.Background(), "atlassian/atlaskit", 1, 114733788)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.List(context.Background(), "atlassian/atlaskit", 1, scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Typo in this heading."},
+			"inline":  map[string]interface{}{"path": "README.md", "to": 12},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Create(context.Background(), "atlassian/atlaskit", 1, &scm.ReviewInput{Body: "Typo in this heading.", Path: "README.md", Line: 12})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/atlaskit/pullrequests/1/comments/114733788").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Delete(context.Background(), "atlassian/atlaskit", 1, 114733788)
+	if err != nil {
+		t.Error(err)
 	}
 }
@@ -7,8 +7,10 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
 )
 
 type userService struct {
@@ -32,6 +34,104 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return "", nil, scm.ErrNotSupported
 }
 
+// FindByEmail is not supported. Bitbucket Cloud does not
+// expose user email addresses in accordance with GDPR.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/user/emails?%s", encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(emails)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertEmailList(out), res, err
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	account, res, err := s.findAccountID(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys?%s", account, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(keys)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	account, res, err := s.findAccountID(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/ssh-keys", account)
+	in := &keyInput{
+		Label: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	account, res, err := s.findAccountID(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/gpg-keys?%s", account, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(gpgKeys)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	account, res, err := s.findAccountID(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("2.0/users/%s/gpg-keys", account)
+	in := &gpgKeyInput{
+		Key: input.Key,
+	}
+	out := new(gpgKey)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertGPGKey(out), res, err
+}
+
+// ListRepositories returns the repositories of the workspace
+// owned by the user account, where the login is the workspace
+// slug.
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s?%s", login, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := new(repositories)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertRepositoryList(out), res, err
+}
+
+// helper function returns the account id of the authenticated
+// user, which is required to manage the user ssh and gpg keys.
+func (s *userService) findAccountID(ctx context.Context) (string, *scm.Response, error) {
+	out := new(user)
+	res, err := s.client.do(ctx, "GET", "2.0/user", nil, out)
+	return out.AccountID, res, err
+}
+
 type user struct {
 	// The `username` field is no longer available after 29 April 2019 in
 	// accordance with GDPR regulations. See:
@@ -45,14 +145,121 @@ type user struct {
 		HTML   link `json:"html"`
 		Avatar link `json:"avatar"`
 	} `json:"links"`
-	Type string `json:"type"`
-	UUID string `json:"uuid"`
+	Type    string    `json:"type"`
+	UUID    string    `json:"uuid"`
+	Created time.Time `json:"created_on"`
 }
 
 func convertUser(from *user) *scm.User {
 	return &scm.User{
-		Avatar: fmt.Sprintf("https://bitbucket.org/account/%s/avatar/32/", from.Username),
-		Login:  from.Username,
-		Name:   from.DisplayName,
+		ID:      from.UUID,
+		Avatar:  fmt.Sprintf("https://bitbucket.org/account/%s/avatar/32/", from.Username),
+		Login:   from.Username,
+		Name:    from.DisplayName,
+		Link:    from.Links.HTML.Href,
+		Type:    convertUserType(from.Type),
+		Created: from.Created,
+	}
+}
+
+func convertUserType(from string) scm.UserType {
+	switch from {
+	case "user":
+		return scm.UserTypeUser
+	case "app_user":
+		return scm.UserTypeBot
+	case "team":
+		return scm.UserTypeOrg
+	default:
+		return scm.UserTypeUndefined
+	}
+}
+
+type emails struct {
+	pagination
+	Values []*email `json:"values"`
+}
+
+type email struct {
+	Email     string `json:"email"`
+	Primary   bool   `json:"is_primary"`
+	Confirmed bool   `json:"is_confirmed"`
+}
+
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+type key struct {
+	UUID    string    `json:"uuid"`
+	Key     string    `json:"key"`
+	Label   string    `json:"label"`
+	Created time.Time `json:"created_on"`
+}
+
+type keyInput struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+type gpgKeys struct {
+	pagination
+	Values []*gpgKey `json:"values"`
+}
+
+type gpgKey struct {
+	KeyID   string    `json:"key_id"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_on"`
+	Expires null.Time `json:"expires_on"`
+}
+
+type gpgKeyInput struct {
+	Key string `json:"key"`
+}
+
+func convertEmailList(from *emails) []*scm.Email {
+	to := []*scm.Email{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Email{
+			Address:  v.Email,
+			Primary:  v.Primary,
+			Verified: v.Confirmed,
+		})
+	}
+	return to
+}
+
+func convertKeyList(from *keys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		Title:   from.Label,
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
+
+func convertGPGKeyList(from *gpgKeys) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from.Values {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	return &scm.GPGKey{
+		KeyID:   from.KeyID,
+		Key:     from.Key,
+		Created: from.Created,
+		Expires: from.Expires.Time,
 	}
 }
@@ -73,3 +73,214 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestUserFindByEmail(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Users.FindByEmail(context.Background(), "brad@example.com")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestUserListEmails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user/emails").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_emails.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.ListEmails(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Email{}
+	raw, _ := ioutil.ReadFile("testdata/user_emails.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/users/557058:2a6349dc-4346-4805-bd84-3abdd0812d17/ssh-keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_keys.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Users.ListKeys(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/user_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestUserCreateKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/users/557058:2a6349dc-4346-4805-bd84-3abdd0812d17/ssh-keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"label": "laptop",
+			"key":   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3Cr632C2dNhhgKVcon4ldUSAeKiku2yP9O9/bDtY",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_key.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	input := &scm.KeyInput{
+		Title: "laptop",
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKqP3Cr632C2dNhhgKVcon4ldUSAeKiku2yP9O9/bDtY",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/user_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListGPGKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/users/557058:2a6349dc-4346-4805-bd84-3abdd0812d17/gpg-keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_gpg_keys.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.ListGPGKeys(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserCreateGPGKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/users/557058:2a6349dc-4346-4805-bd84-3abdd0812d17/gpg-keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"key": "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_gpg_key.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	input := &scm.GPGKeyInput{
+		Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.CreateGPGKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.GPGKey)
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian").
+		MatchParam("pagelen", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.ListRepositories(context.Background(), "atlassian", scm.ListOptions{Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/workspace_repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
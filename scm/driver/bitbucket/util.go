@@ -5,9 +5,11 @@
 package bitbucket
 
 import (
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -55,6 +57,9 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
 	return params.Encode()
 }
 
@@ -66,10 +71,14 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
-	if opts.Open && opts.Closed {
-		params.Set("state", "all")
-	} else if opts.Closed {
-		params.Set("state", "closed")
+	// the issues endpoint ignores the state parameter and
+	// only filters by query. Issues are open unless they are
+	// in one of the closed states of isIssueClosed, which
+	// includes the on hold state.
+	if opts.Open && !opts.Closed {
+		params.Set("q", `(state!="resolved" AND state!="invalid" AND state!="duplicate" AND state!="wontfix" AND state!="closed")`)
+	} else if opts.Closed && !opts.Open {
+		params.Set("q", `(state="resolved" OR state="invalid" OR state="duplicate" OR state="wontfix" OR state="closed")`)
 	}
 	return params.Encode()
 }
@@ -87,6 +96,38 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if opts.Merged {
+		params.Set("state", "MERGED")
+	}
+	var query []string
+	if opts.Source != "" {
+		query = append(query, fmt.Sprintf("source.branch.name = %q", opts.Source))
+	}
+	if opts.Target != "" {
+		query = append(query, fmt.Sprintf("destination.branch.name = %q", opts.Target))
+	}
+	if opts.Author != "" {
+		query = append(query, fmt.Sprintf("author.nickname = %q", opts.Author))
+	}
+	if !opts.Since.IsZero() {
+		query = append(query, "updated_on >= "+opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	if len(query) != 0 {
+		params.Set("q", strings.Join(query, " AND "))
+	}
+	var sort string
+	switch opts.Sort {
+	case scm.SortByCreated:
+		sort = "created_on"
+	case scm.SortByUpdated:
+		sort = "updated_on"
+	}
+	if sort != "" && opts.Direction == scm.SortDesc {
+		sort = "-" + sort
+	}
+	if sort != "" {
+		params.Set("sort", sort)
+	}
 	return params.Encode()
 }
 
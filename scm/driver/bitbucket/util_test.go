@@ -5,7 +5,9 @@
 package bitbucket
 
 import (
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -35,6 +37,18 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Filter(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Ref:  "master",
+		Path: "docs",
+	}
+	want := "path=docs"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -42,7 +56,29 @@ func Test_encodeIssueListOptions(t *testing.T) {
 		Open:   true,
 		Closed: true,
 	}
-	want := "page=10&pagelen=30&state=all"
+	want := "page=10&pagelen=30"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Open(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Open: true,
+	}
+	want := "q=%28state%21%3D%22resolved%22+AND+state%21%3D%22invalid%22+AND+state%21%3D%22duplicate%22+AND+state%21%3D%22wontfix%22+AND+state%21%3D%22closed%22%29"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Closed(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Closed: true,
+	}
+	want := "q=%28state%3D%22resolved%22+OR+state%3D%22invalid%22+OR+state%3D%22duplicate%22+OR+state%3D%22wontfix%22+OR+state%3D%22closed%22%29"
 	got := encodeIssueListOptions(opts)
 	if got != want {
 		t.Errorf("Want encoded issue list options %q, got %q", want, got)
@@ -64,6 +100,27 @@ func Test_encodePullRequestListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodePullRequestListOptions_Filter(t *testing.T) {
+	t.Parallel()
+	opts := scm.PullRequestListOptions{
+		Merged:    true,
+		Source:    "feature/x",
+		Target:    "master",
+		Author:    "jcitizen",
+		Since:     time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		Sort:      scm.SortByUpdated,
+		Direction: scm.SortDesc,
+	}
+	want := url.Values{}
+	want.Set("state", "MERGED")
+	want.Set("q", `source.branch.name = "feature/x" AND destination.branch.name = "master" AND author.nickname = "jcitizen" AND updated_on >= 2018-01-01T00:00:00Z`)
+	want.Set("sort", "-updated_on")
+	got := encodePullRequestListOptions(opts)
+	if got != want.Encode() {
+		t.Errorf("Want encoded pr list options %q, got %q", want.Encode(), got)
+	}
+}
+
 func Test_copyPagination(t *testing.T) {
 	tests := []struct {
 		from pagination
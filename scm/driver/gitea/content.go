@@ -27,15 +27,42 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message:   params.Message,
+		Branch:    params.Branch,
+		Content:   params.Data,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	return s.client.do(ctx, "POST", endpoint, in, nil)
 }
 
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message: params.Message,
+		Branch:  params.Branch,
+		Content: params.Data,
+		// NB the sha passed to gitea rest api is the blob sha, not the commit sha
+		Sha:       params.BlobID,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
 }
 
 func (s *contentService) Delete(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message: params.Message,
+		Branch:  params.Branch,
+		// NB the sha passed to gitea rest api is the blob sha, not the commit sha
+		Sha:       params.BlobID,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	return s.client.do(ctx, "DELETE", endpoint, in, nil)
 }
 
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
@@ -47,9 +74,24 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm
 
 type content struct {
 	Path string `json:"path"`
+	Sha  string `json:"sha"`
 	Type string `json:"type"`
 }
 
+type contentCreateUpdate struct {
+	Branch    string   `json:"branch,omitempty"`
+	Message   string   `json:"message"`
+	Content   []byte   `json:"content,omitempty"`
+	Sha       string   `json:"sha,omitempty"`
+	Author    identity `json:"author"`
+	Committer identity `json:"committer"`
+}
+
+type identity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
 func convertContentInfoList(from []*content) []*scm.ContentInfo {
 	to := []*scm.ContentInfo{}
 	for _, v := range from {
@@ -59,7 +101,10 @@ func convertContentInfoList(from []*content) []*scm.ContentInfo {
 }
 
 func convertContentInfo(from *content) *scm.ContentInfo {
-	to := &scm.ContentInfo{Path: from.Path}
+	to := &scm.ContentInfo{
+		Path:   from.Path,
+		BlobID: from.Sha,
+	}
 	switch from.Type {
 	case "file":
 		to.Kind = scm.ContentKindFile
@@ -74,3 +119,10 @@ func convertContentInfo(from *content) *scm.ContentInfo {
 	}
 	return to
 }
+
+func convertIdentity(from scm.Signature) identity {
+	return identity{
+		Name:  from.Name,
+		Email: from.Email,
+	}
+}
@@ -44,26 +44,139 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents/test/hello").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"message":   "my commit message",
+			"content":   "bXkgbmV3IGZpbGUgY29udGVudHM=",
+			"branch":    "master",
+			"author":    map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+			"committer": map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/content_create.json")
+
+	params := &scm.ContentParams{
+		Message: "my commit message",
+		Branch:  "master",
+		Data:    []byte("my new file contents"),
+		Signature: scm.Signature{
+			Name:  "Lunny Xiao",
+			Email: "xiaolunwen@gmail.com",
+		},
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "test/hello", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/contents/test/hello").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"message":   "a new commit message",
+			"content":   "bXkgdXBkYXRlZCBmaWxlIGNvbnRlbnRz",
+			"sha":       "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+			"author":    map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+			"committer": map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	params := &scm.ContentParams{
+		Message: "a new commit message",
+		Data:    []byte("my updated file contents"),
+		BlobID:  "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+		Signature: scm.Signature{
+			Name:  "Lunny Xiao",
+			Email: "xiaolunwen@gmail.com",
+		},
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "test/hello", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestContentUpdateBadBlobID(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/contents/test/hello").
+		Reply(422).
+		Type("application/json").
+		BodyString(`{"message":"sha does not match"}`)
+
+	params := &scm.ContentParams{
+		Message: "a new commit message",
+		Data:    []byte("my updated file contents"),
+		BlobID:  "0000000000000000000000000000000000000000",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "test/hello", params)
+	if err == nil {
+		t.Errorf("Expect error updating content with a bad blob id")
 	}
 }
 
 func TestContentDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/contents/test/hello").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"message":   "a new commit message",
+			"sha":       "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+			"author":    map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+			"committer": map[string]string{"name": "Lunny Xiao", "email": "xiaolunwen@gmail.com"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_delete.json")
+
+	params := &scm.ContentParams{
+		Message: "a new commit message",
+		BlobID:  "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+		Signature: scm.Signature{
+			Name:  "Lunny Xiao",
+			Email: "xiaolunwen@gmail.com",
+		},
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "README.md", &scm.ContentParams{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "test/hello", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
 	}
 }
 
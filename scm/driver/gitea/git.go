@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
@@ -18,7 +19,12 @@ type gitService struct {
 }
 
 func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.CreateBranch) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/branches", repo)
+	in := &createBranch{
+		Name:    scm.TrimRef(params.Name),
+		OldName: params.Sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
 }
 
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
@@ -59,11 +65,11 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 	return convertBranchList(out), res, err
 }
 
-func (s *gitService) ListCommits(ctx context.Context, repo string, _ scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
-	path := fmt.Sprintf("api/v1/repos/%s/commits", repo)
+func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/commits?%s", repo, encodeCommitListOptions(opts))
 	out := []*commitInfo{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
-	return convertCommitList(out), res, err
+	return convertCommitList(filterCommits(out, opts)), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -74,11 +80,30 @@ func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOption
 }
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s", repo, url.PathEscape(ref))
+	out := new(commitInfo)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertChangeList(out.Files), res, err
+}
+
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s.%s", repo, ref, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
+// CompareDiff returns the raw diff between two commits.
+// Gitea does not provide an api endpoint, and the diff is
+// downloaded from the web interface instead.
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("%s/compare/%s...%s.%s", repo, source, target, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
 }
 
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, source, target)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCompareChanges(out.Commits), res, err
 }
 
 //
@@ -105,17 +130,38 @@ type (
 
 	// gitea commit info object.
 	commitInfo struct {
-		Sha       string `json:"sha"`
-		Commit    commit `json:"commit"`
-		Author    user   `json:"author"`
-		Committer user   `json:"committer"`
+		Sha       string        `json:"sha"`
+		Commit    commit        `json:"commit"`
+		Author    user          `json:"author"`
+		Committer user          `json:"committer"`
+		Files     []*commitFile `json:"files"`
+	}
+
+	// gitea commit affected file object.
+	commitFile struct {
+		Filename string `json:"filename"`
+		Status   string `json:"status"`
+	}
+
+	// gitea compare object.
+	compare struct {
+		TotalCommits int           `json:"total_commits"`
+		Commits      []*commitInfo `json:"commits"`
+	}
+
+	// gitea create branch object. The old branch name
+	// accepts a branch name or commit sha.
+	createBranch struct {
+		Name    string `json:"new_branch_name"`
+		OldName string `json:"old_ref_name"`
 	}
 
 	// gitea signature object.
 	signature struct {
-		Name     string `json:"name"`
-		Email    string `json:"email"`
-		Username string `json:"username"`
+		Name     string    `json:"name"`
+		Email    string    `json:"email"`
+		Username string    `json:"username"`
+		Date     time.Time `json:"date"`
 	}
 
 	// gitea tag object
@@ -150,6 +196,28 @@ func convertBranch(src *branch) *scm.Reference {
 	}
 }
 
+// filterCommits applies the commit list filters that
+// cannot be expressed as gitea query parameters.
+func filterCommits(src []*commitInfo, opts scm.CommitListOptions) []*commitInfo {
+	dst := []*commitInfo{}
+	for _, v := range src {
+		author := v.Commit.Author
+		switch {
+		case opts.Author != "" &&
+			opts.Author != userLogin(&v.Author) &&
+			opts.Author != author.Name &&
+			opts.Author != author.Email:
+			continue
+		case !opts.Since.IsZero() && author.Date.Before(opts.Since):
+			continue
+		case !opts.Until.IsZero() && author.Date.After(opts.Until):
+			continue
+		}
+		dst = append(dst, v)
+	}
+	return dst
+}
+
 func convertCommitList(src []*commitInfo) []*scm.Commit {
 	dst := []*scm.Commit{}
 	for _, v := range src {
@@ -185,6 +253,63 @@ func convertUserSignature(src user) scm.Signature {
 	}
 }
 
+func convertChangeList(src []*commitFile) []*scm.Change {
+	dst := []*scm.Change{}
+	for _, v := range src {
+		dst = append(dst, convertChange(v))
+	}
+	return dst
+}
+
+func convertChange(src *commitFile) *scm.Change {
+	return &scm.Change{
+		Path:    src.Filename,
+		Added:   src.Status == "added",
+		Deleted: src.Status == "removed",
+		Renamed: src.Status == "renamed",
+	}
+}
+
+// convertCompareChanges returns the files changed by the
+// list of commits. Gitea does not return the files of the
+// comparison, and the changes of each commit are combined
+// instead. The commits are returned newest first, and are
+// combined in reverse order. The commit files only include
+// the status, and the line statistics and patch of the
+// comparison are not available.
+func convertCompareChanges(src []*commitInfo) []*scm.Change {
+	var paths []string
+	first := map[string]*commitFile{}
+	last := map[string]*commitFile{}
+	for i := len(src) - 1; i >= 0; i-- {
+		for _, file := range src[i].Files {
+			if _, ok := first[file.Filename]; !ok {
+				first[file.Filename] = file
+				paths = append(paths, file.Filename)
+			}
+			last[file.Filename] = file
+		}
+	}
+	dst := []*scm.Change{}
+	for _, path := range paths {
+		added := first[path].Status == "added"
+		deleted := last[path].Status == "removed"
+		switch {
+		case added && deleted:
+			// the file was added and removed again.
+			continue
+		case added:
+			dst = append(dst, &scm.Change{Path: path, Added: true})
+		case first[path].Status == "removed" && !deleted:
+			// the file was removed and added again.
+			dst = append(dst, &scm.Change{Path: path})
+		default:
+			dst = append(dst, convertChange(last[path]))
+		}
+	}
+	return dst
+}
+
 func convertTagList(src []*tag) []*scm.Reference {
 	var dst []*scm.Reference
 	for _, v := range src {
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
@@ -69,25 +70,103 @@ func TestGitListCommits(t *testing.T) {
 	}
 }
 
+func TestGitListCommitsFilter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/commits").
+		MatchParam("sha", "master").
+		MatchParam("path", "routers/api").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{
+		Ref:    "master",
+		Path:   "routers/api",
+		Author: "lewiscowles@me.com",
+		Until:  time.Date(2018, 9, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(got) != 1 {
+		t.Errorf("Expect 1 commit by author, got %d", len(got))
+	}
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/commits").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	got, _, err = client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{
+		Since: time.Date(2018, 9, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expect commits filtered by date, got %d", len(got))
+	}
+}
+
 func TestGitListChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/commits/c43399cad8766ee521b873a32c1652407c5a4630").
+		Reply(200).
+		Type("application/json").
+		File("testdata/changes.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "c43399cad8766ee521b873a32c1652407c5a4630", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/changes.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestGitCompareChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/d293a2b9d6722dffde7998c953c3087e47a38a83...f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Git.CompareChanges(
+	got, _, err := client.Git.CompareChanges(
 		context.Background(),
 		"go-gitea/gitea",
 		"d293a2b9d6722dffde7998c953c3087e47a38a83",
 		"f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
 		scm.ListOptions{},
 	)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/compare.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -95,6 +174,62 @@ func TestGitCompareChanges(t *testing.T) {
 // branch sub-tests
 //
 
+func TestGitCompareDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/go-gitea/gitea/compare/master...topic.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/pr.diff")
+
+	client, _ := New("https://try.gitea.io")
+	rc, _, err := client.Git.CompareDiff(context.Background(), "go-gitea/gitea", "master", "topic", scm.DiffOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branches").
+		MatchType("json").
+		JSON(map[string]string{
+			"new_branch_name": "feature",
+			"old_ref_name":    "c43399cad8766ee521b873a32c1652407c5a4630",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch_create.json")
+
+	params := &scm.CreateBranch{
+		Name: "feature",
+		Sha:  "c43399cad8766ee521b873a32c1652407c5a4630",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Git.CreateBranch(context.Background(), "go-gitea/gitea", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
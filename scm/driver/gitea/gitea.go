@@ -41,6 +41,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -100,3 +101,27 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// the json response.
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
+
+// stream sends a GET request and returns the response body
+// without reading it. The caller must close the returned
+// reader.
+func (c *wrapper) stream(ctx context.Context, path string, limit int64) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if an error is encountered, return the error
+	// response.
+	if res.Status > 300 {
+		res.Body.Close()
+		return nil, res, errors.New(
+			http.StatusText(res.Status),
+		)
+	}
+	return scm.LimitReadCloser(res.Body, limit), res, nil
+}
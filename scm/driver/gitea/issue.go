@@ -24,7 +24,10 @@ func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
@@ -68,13 +71,19 @@ func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &stateInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
+// Lock is not supported. Gitea does not provide an api
+// endpoint to lock the issue conversation.
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// Unlock is not supported. Gitea does not provide an api
+// endpoint to unlock the issue conversation.
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -122,6 +131,11 @@ type (
 	issueCommentInput struct {
 		Body string `json:"body"`
 	}
+
+	// gitea issue and pull request state request object.
+	stateInput struct {
+		State string `json:"state"`
+	}
 )
 
 //
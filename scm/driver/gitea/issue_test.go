@@ -100,10 +100,24 @@ func TestIssueCreate(t *testing.T) {
 }
 
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/issues/1").
+		MatchType("json").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
@@ -128,10 +142,27 @@ func TestIssueUnlock(t *testing.T) {
 //
 
 func TestIssueCommentFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/comments/74").
+		Reply(200).
+		Type("application/json").
+		File("testdata/comment.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Issues.FindComment(context.Background(), "gogits/go-gogs-client", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Issues.FindComment(context.Background(), "go-gitea/gitea", 1, 74)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -50,3 +50,59 @@ func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Refer
 
 	return fmt.Sprintf("%s%s/compare/%s...%s", l.base, repo, s, t), nil
 }
+
+// File returns a link to the file.
+func (l *linker) File(ctx context.Context, repo string, ref scm.Reference, path string, lines scm.LineRange) (string, error) {
+	return fmt.Sprintf("%s%s/src/%s/%s%s", l.base, repo, refPath(ref), path, lineAnchor(lines)), nil
+}
+
+// Blame returns a link to the blame view of the file.
+func (l *linker) Blame(ctx context.Context, repo string, ref scm.Reference, path string) (string, error) {
+	return fmt.Sprintf("%s%s/blame/%s/%s", l.base, repo, refPath(ref), path), nil
+}
+
+// Issue returns a link to the issue.
+func (l *linker) Issue(ctx context.Context, repo string, number int) (string, error) {
+	return fmt.Sprintf("%s%s/issues/%d", l.base, repo, number), nil
+}
+
+// PullRequestComment returns a link to the pull request comment.
+func (l *linker) PullRequestComment(ctx context.Context, repo string, number, id int) (string, error) {
+	return fmt.Sprintf("%s%s/pulls/%d#issuecomment-%d", l.base, repo, number, id), nil
+}
+
+// Release returns a link to the release.
+func (l *linker) Release(ctx context.Context, repo, tag string) (string, error) {
+	return fmt.Sprintf("%s%s/releases/tag/%s", l.base, repo, tag), nil
+}
+
+// User returns a link to the user profile.
+func (l *linker) User(ctx context.Context, login string) (string, error) {
+	return fmt.Sprintf("%s%s", l.base, login), nil
+}
+
+// refPath returns the path segment that identifies the
+// reference, which gitea prefixes with the reference kind.
+func refPath(ref scm.Reference) string {
+	switch {
+	case ref.Sha != "":
+		return "commit/" + ref.Sha
+	case scm.IsTag(ref.Path):
+		return "tag/" + scm.TrimRef(ref.Path)
+	default:
+		return "branch/" + scm.TrimRef(ref.Path)
+	}
+}
+
+// lineAnchor returns the url fragment that highlights the
+// range of lines.
+func lineAnchor(lines scm.LineRange) string {
+	switch {
+	case lines.Start == 0:
+		return ""
+	case lines.End == 0 || lines.End == lines.Start:
+		return fmt.Sprintf("#L%d", lines.Start)
+	default:
+		return fmt.Sprintf("#L%d-L%d", lines.Start, lines.End)
+	}
+}
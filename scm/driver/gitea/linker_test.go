@@ -112,3 +112,66 @@ func TestLink_Base(t *testing.T) {
 		t.Errorf("Want link %q, got %q", want, got)
 	}
 }
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		link func(context.Context, scm.Linker) (string, error)
+		want string
+	}{
+		{
+			name: "File",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.File(ctx, "octocat/hello-world", scm.Reference{Path: "refs/heads/master", Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"}, "README.md", scm.LineRange{Start: 10, End: 20})
+			},
+			want: "https://try.gitea.io/octocat/hello-world/src/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde/README.md#L10-L20",
+		},
+		{
+			name: "Blame",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Blame(ctx, "octocat/hello-world", scm.Reference{Path: "refs/heads/master"}, "README.md")
+			},
+			want: "https://try.gitea.io/octocat/hello-world/blame/branch/master/README.md",
+		},
+		{
+			name: "Issue",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Issue(ctx, "octocat/hello-world", 42)
+			},
+			want: "https://try.gitea.io/octocat/hello-world/issues/42",
+		},
+		{
+			name: "PullRequestComment",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.PullRequestComment(ctx, "octocat/hello-world", 42, 1)
+			},
+			want: "https://try.gitea.io/octocat/hello-world/pulls/42#issuecomment-1",
+		},
+		{
+			name: "Release",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Release(ctx, "octocat/hello-world", "v1.0.0")
+			},
+			want: "https://try.gitea.io/octocat/hello-world/releases/tag/v1.0.0",
+		},
+		{
+			name: "User",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.User(ctx, "octocat")
+			},
+			want: "https://try.gitea.io/octocat",
+		},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	for _, test := range tests {
+		got, err := test.link(context.Background(), client.Linker)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if want := test.want; got != want {
+			t.Errorf("%s: want link %q, got %q", test.name, want, got)
+		}
+	}
+}
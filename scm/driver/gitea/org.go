@@ -33,15 +33,167 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrgList(out), res, err
 }
 
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/members?%s", name, encodeListOptions(opts))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	owners, err := s.listOwners(ctx, name)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertMemberList(out, owners), res, nil
+}
+
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/teams?%s", name, encodeListOptions(opts))
+	out := []*team{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+// ListTeamMembers returns the team members, where the team is
+// identified by its numeric id. Members are granted the admin
+// role if the team has owner or admin permission.
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, id string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/teams/%s", id)
+	in := new(team)
+	res, err := s.client.do(ctx, "GET", path, nil, in)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("api/v1/teams/%s/members?%s", id, encodeListOptions(opts))
+	out := []*user{}
+	res, err = s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertMemberList(out, nil)
+	if in.Permission == "owner" || in.Permission == "admin" {
+		for _, v := range to {
+			v.Role = scm.RoleAdmin
+		}
+	}
+	return to, res, nil
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/repos?%s", name, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+// AddMember is not supported. Gitea organization members are
+// added by adding the user to one of the organization teams.
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/members/%s", name, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/hooks?%s", name, encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/orgs/%s/hooks", name)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	in, err := convertFromHookInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("api/v1/orgs/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/hooks/%s", name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the set of organization owners.
+// Gitea does not include the role in the member list, so the
+// members of the owners team are listed instead.
+func (s *organizationService) listOwners(ctx context.Context, name string) (map[string]bool, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/teams/search?q=Owners", name)
+	out := new(teamSearch)
+	if _, err := s.client.do(ctx, "GET", path, nil, out); err != nil {
+		return nil, err
+	}
+	owners := map[string]bool{}
+	for _, t := range out.Data {
+		if t.Permission != "owner" {
+			continue
+		}
+		opts := scm.ListOptions{Page: 1, Size: 50}
+		for {
+			path := fmt.Sprintf("api/v1/teams/%d/members?%s", t.ID, encodeListOptions(opts))
+			list := []*user{}
+			res, err := s.client.do(ctx, "GET", path, nil, &list)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range list {
+				owners[userLogin(v)] = true
+			}
+			if res.Page.Next == 0 {
+				break
+			}
+			opts.Page = res.Page.Next
+		}
+	}
+	return owners, nil
+}
+
 //
 // native data structures
 //
 
 type org struct {
+	ID     int    `json:"id"`
 	Name   string `json:"username"`
 	Avatar string `json:"avatar_url"`
 }
 
+type team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Permission  string `json:"permission"`
+}
+
+type teamSearch struct {
+	Data []*team `json:"data"`
+	OK   bool    `json:"ok"`
+}
+
 //
 // native data structure conversion
 //
@@ -60,3 +212,35 @@ func convertOrg(from *org) *scm.Organization {
 		Avatar: from.Avatar,
 	}
 }
+
+func convertMemberList(from []*user, owners map[string]bool) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from {
+		member := &scm.Member{
+			User: *convertUser(v),
+			Role: scm.RoleMember,
+		}
+		if owners[userLogin(v)] {
+			member.Role = scm.RoleAdmin
+		}
+		to = append(to, member)
+	}
+	return to
+}
+
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          from.ID,
+		Name:        from.Name,
+		Slug:        from.Name,
+		Description: from.Description,
+	}
+}
@@ -73,3 +73,268 @@ func TestOrgList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrganizationListMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams/search").
+		MatchParam("q", "Owners").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team_search.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/1/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/owners.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListMembers(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/members.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/teams.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/1/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/members.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/teams/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "gogits", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/team_members.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/repos").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListRepositories(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationAddMember(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Organizations.AddMember(context.Background(), "gogits", "jcitizen", scm.RoleMember)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestOrganizationRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/orgs/gogits/members/jcitizen").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Organizations.RemoveMember(context.Background(), "gogits", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestOrgHookFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.FindHook(context.Background(), "gogits", "20")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits/hooks").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hooks.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/hooks.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/orgs/gogits/hooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "gogits", &scm.HookInput{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/orgs/gogits/hooks/20").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "gogits", "20", &scm.HookInput{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/orgs/gogits/hooks/20").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Organizations.DeleteHook(context.Background(), "gogits", "20")
+	if err != nil {
+		t.Error(err)
+	}
+}
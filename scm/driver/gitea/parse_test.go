@@ -0,0 +1,84 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/pulls/42/files",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/issues/1",
+			want: scm.Resource{Kind: scm.ResourceKindIssue, Number: 1},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/commit/2eba238e33607e5fa49333a1e6c7b1fcc6fd3c1f",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "2eba238e33607e5fa49333a1e6c7b1fcc6fd3c1f"},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/src/branch/master/docs",
+			want: scm.Resource{Kind: scm.ResourceKindTree, Ref: "master", Path: "docs"},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/src/tag/v1.0.0/README.md#L10-L20",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "v1.0.0", Path: "README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://try.gitea.io/go-gitea/gitea/releases/tag/v1.0.0",
+			want: scm.Resource{Kind: scm.ResourceKindRelease, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://try.gitea.io/api/v1/repos/go-gitea/gitea/pulls/42",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://try.gitea.io/api/v1/repos/go-gitea/gitea/git/commits/2eba238e33607e5fa49333a1e6c7b1fcc6fd3c1f",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "2eba238e33607e5fa49333a1e6c7b1fcc6fd3c1f"},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverGitea
+		want.Host = "try.gitea.io"
+		want.Repo = "go-gitea/gitea"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://try.gitea.io/go-gitea",
+		"https://try.gitea.io/go-gitea/gitea/src/master/README.md",
+		"https://try.gitea.io/go-gitea/gitea/pulls/foo",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -23,27 +24,38 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
+	return s.issues().FindComment(ctx, repo, index, id)
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/pulls?%s", repo, encodePullRequestListOptions(opts))
 	out := []*pr{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
-	return convertPullRequests(out), res, err
+	return filterPullRequests(convertPullRequests(out), opts), res, err
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d.%s", repo, number, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
 }
 
-func (s *pullService) ListCommits(context.Context, string, int, scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	return s.issues().ListComments(ctx, repo, index, opts)
 }
 
-func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListCommits(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/commits?%s", repo, index, encodeListOptions(opts))
+	out := []*commitInfo{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
+}
+
+func (s *pullService) ListChanges(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/files?%s", repo, index, encodeListOptions(opts))
+	out := []*changedFile{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertChangedFileList(out), res, err
 }
 
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
@@ -59,12 +71,12 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	return s.issues().CreateComment(ctx, repo, index, input)
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) DeleteComment(ctx context.Context, repo string, index, id int) (*scm.Response, error) {
+	return s.issues().DeleteComment(ctx, repo, index, id)
 }
 
 func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.Response, error) {
@@ -73,8 +85,16 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.R
 	return res, err
 }
 
-func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) Close(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &stateInput{State: "closed"}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
+// issues returns the issue service. Gitea pull request
+// comments are issue comments.
+func (s *pullService) issues() *issueService {
+	return &issueService{s.client}
 }
 
 //
@@ -112,6 +132,15 @@ type reference struct {
 	Sha  string     `json:"sha"`
 }
 
+// gitea pull request changed file object.
+type changedFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+}
+
 type prInput struct {
 	Title string `json:"title"`
 	Body  string `json:"body"`
@@ -131,6 +160,52 @@ func convertPullRequests(src []*pr) []*scm.PullRequest {
 	return dst
 }
 
+// helper function filters the pull request list using the
+// list options that are not supported by the gitea api.
+func filterPullRequests(src []*scm.PullRequest, opts scm.PullRequestListOptions) []*scm.PullRequest {
+	dst := []*scm.PullRequest{}
+	for _, v := range src {
+		if opts.Merged && !v.Merged {
+			continue
+		}
+		if opts.Source != "" && v.Source != opts.Source {
+			continue
+		}
+		if opts.Target != "" && v.Target != opts.Target {
+			continue
+		}
+		if opts.Author != "" && v.Author.Login != opts.Author {
+			continue
+		}
+		if !opts.Since.IsZero() && v.Updated.Before(opts.Since) {
+			continue
+		}
+		if !hasLabels(v, opts.Labels) {
+			continue
+		}
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// helper function returns true if the pull request has
+// all of the named labels.
+func hasLabels(pr *scm.PullRequest, names []string) bool {
+	for _, name := range names {
+		found := false
+		for _, label := range pr.Labels {
+			if label.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func convertPullRequest(src *pr) *scm.PullRequest {
 	var labels []scm.Label
 	for _, label := range src.Labels {
@@ -159,6 +234,29 @@ func convertPullRequest(src *pr) *scm.PullRequest {
 	}
 }
 
+func convertChangedFileList(src []*changedFile) []*scm.Change {
+	dst := []*scm.Change{}
+	for _, v := range src {
+		dst = append(dst, convertChangedFile(v))
+	}
+	return dst
+}
+
+func convertChangedFile(src *changedFile) *scm.Change {
+	to := &scm.Change{
+		Path:      src.Filename,
+		Added:     src.Status == "added",
+		Deleted:   src.Status == "deleted" || src.Status == "removed",
+		Renamed:   src.Status == "renamed",
+		Additions: src.Additions,
+		Deletions: src.Deletions,
+	}
+	if to.Renamed {
+		to.PrevPath = src.PreviousFilename
+	}
+	return to
+}
+
 func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 	return &scm.PullRequest{
 		Number:  src.Number,
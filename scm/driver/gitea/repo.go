@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"time"
 
@@ -60,26 +61,40 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo string, ref str
 	return convertStatusList(out), res, err
 }
 
+func (s *repositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/topics", repo)
+	out := new(topics)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out.Topics, res, err
+}
+
+func (s *repositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/languages", repo)
+	out := map[string]int64{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLanguageList(out), res, err
+}
+
+func (s *repositoryService) ReplaceTopics(ctx context.Context, repo string, names []string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/topics", repo)
+	in := &topics{Topics: names}
+	if in.Topics == nil {
+		in.Topics = []string{}
+	}
+	// gitea responds with an empty body on success.
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return in.Topics, res, nil
+}
+
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("api/v1/repos/%s/hooks", repo)
-	in := new(hook)
-	in.Type = "gitea"
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = target.String()
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvent(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -99,25 +114,11 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo string, ref s
 }
 
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	target, err := url.Parse(input.Target)
+	in, err := convertFromHookInput(input)
 	if err != nil {
 		return nil, nil, err
 	}
-	params := target.Query()
-	params.Set("secret", input.Secret)
-	target.RawQuery = params.Encode()
-
 	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s", repo, id)
-	in := new(hook)
-	in.Type = "gitea"
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = target.String()
-	in.Events = append(
-		input.NativeEvents,
-		convertHookEvent(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
 	return convertHook(out), res, err
@@ -128,6 +129,30 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries is not supported. The Gitea api does
+// not expose the hook task history.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindHookDelivery is not supported. The Gitea api does
+// not expose the hook task history.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. The Gitea api does not
+// expose the hook task history.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// PingHook sends a test push event to a repository hook.
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/hooks/%s/tests", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 //
 // native data structures
 //
@@ -135,19 +160,27 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 type (
 	// gitea repository resource.
 	repository struct {
-		ID            int       `json:"id"`
-		Owner         user      `json:"owner"`
-		Name          string    `json:"name"`
-		FullName      string    `json:"full_name"`
-		Private       bool      `json:"private"`
-		Fork          bool      `json:"fork"`
-		HTMLURL       string    `json:"html_url"`
-		SSHURL        string    `json:"ssh_url"`
-		CloneURL      string    `json:"clone_url"`
-		DefaultBranch string    `json:"default_branch"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		Permissions   perm      `json:"permissions"`
+		ID            int         `json:"id"`
+		Owner         user        `json:"owner"`
+		Name          string      `json:"name"`
+		FullName      string      `json:"full_name"`
+		Private       bool        `json:"private"`
+		Fork          bool        `json:"fork"`
+		HTMLURL       string      `json:"html_url"`
+		SSHURL        string      `json:"ssh_url"`
+		CloneURL      string      `json:"clone_url"`
+		DefaultBranch string      `json:"default_branch"`
+		CreatedAt     time.Time   `json:"created_at"`
+		UpdatedAt     time.Time   `json:"updated_at"`
+		Permissions   perm        `json:"permissions"`
+		Description   string      `json:"description"`
+		Topics        []string    `json:"topics"`
+		Language      string      `json:"language"`
+		Size          int64       `json:"size"`
+		Archived      bool        `json:"archived"`
+		Stars         int         `json:"stars_count"`
+		OpenIssues    int         `json:"open_issues_count"`
+		Parent        *repository `json:"parent"`
 	}
 
 	// gitea permissions details.
@@ -173,6 +206,11 @@ type (
 		Secret      string `json:"secret"`
 	}
 
+	// gitea repository topics.
+	topics struct {
+		Topics []string `json:"topics"`
+	}
+
 	// gitea status resource.
 	status struct {
 		CreatedAt   time.Time `json:"created_at"`
@@ -205,17 +243,51 @@ func convertRepositoryList(src []*repository) []*scm.Repository {
 }
 
 func convertRepository(src *repository) *scm.Repository {
-	return &scm.Repository{
-		ID:        strconv.Itoa(src.ID),
-		Namespace: userLogin(&src.Owner),
-		Name:      src.Name,
-		Perm:      convertPerm(src.Permissions),
-		Branch:    src.DefaultBranch,
-		Private:   src.Private,
-		Clone:     src.CloneURL,
-		CloneSSH:  src.SSHURL,
-		Link:      src.HTMLURL,
+	dst := &scm.Repository{
+		ID:          strconv.Itoa(src.ID),
+		Namespace:   userLogin(&src.Owner),
+		Name:        src.Name,
+		Perm:        convertPerm(src.Permissions),
+		Branch:      src.DefaultBranch,
+		Private:     src.Private,
+		Clone:       src.CloneURL,
+		CloneSSH:    src.SSHURL,
+		Link:        src.HTMLURL,
+		Description: src.Description,
+		Topics:      src.Topics,
+		Language:    src.Language,
+		Size:        src.Size,
+		Archived:    src.Archived,
+		Stars:       src.Stars,
+		OpenIssues:  src.OpenIssues,
+		Fork:        src.Fork,
+	}
+	if src.Parent != nil {
+		dst.Parent = src.Parent.FullName
+	}
+	return dst
+}
+
+func convertLanguageList(src map[string]int64) []*scm.Language {
+	var total int64
+	for _, v := range src {
+		total += v
 	}
+	dst := []*scm.Language{}
+	for k, v := range src {
+		lang := &scm.Language{Name: k, Bytes: v}
+		if total > 0 {
+			lang.Percent = float64(v) * 100 / float64(total)
+		}
+		dst = append(dst, lang)
+	}
+	sort.Slice(dst, func(i, j int) bool {
+		if dst[i].Bytes == dst[j].Bytes {
+			return dst[i].Name < dst[j].Name
+		}
+		return dst[i].Bytes > dst[j].Bytes
+	})
+	return dst
 }
 
 func convertPerm(src perm) *scm.Perm {
@@ -243,6 +315,28 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertFromHookInput(input *scm.HookInput) (*hook, error) {
+	target, err := url.Parse(input.Target)
+	if err != nil {
+		return nil, err
+	}
+	params := target.Query()
+	params.Set("secret", input.Secret)
+	target.RawQuery = params.Encode()
+
+	dst := new(hook)
+	dst.Type = "gitea"
+	dst.Active = true
+	dst.Config.Secret = input.Secret
+	dst.Config.ContentType = "json"
+	dst.Config.URL = target.String()
+	dst.Events = append(
+		input.NativeEvents,
+		convertHookEvent(input.Events)...,
+	)
+	return dst, nil
+}
+
 func convertHookEvent(from scm.HookEvents) []string {
 	var events []string
 	if from.PullRequest {
@@ -261,6 +355,23 @@ func convertHookEvent(from scm.HookEvents) []string {
 	if from.Push {
 		events = append(events, "push")
 	}
+	if from.PullRequestReview {
+		events = append(events, "pull_request_review_approved")
+		events = append(events, "pull_request_review_rejected")
+		events = append(events, "pull_request_review_comment")
+	}
+	if from.Release {
+		events = append(events, "release")
+	}
+	if from.Status {
+		events = append(events, "status")
+	}
+	if from.Repository {
+		events = append(events, "repository")
+	}
+	if from.Wiki {
+		events = append(events, "wiki")
+	}
 	return events
 }
 
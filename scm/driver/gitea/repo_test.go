@@ -115,6 +115,83 @@ func TestRepoNotFound(t *testing.T) {
 // hook sub-tests
 //
 
+func TestRepoTopicList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/topics").
+		Reply(200).
+		Type("application/json").
+		File("testdata/topics.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.ListTopics(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoTopicReplace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/topics").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"topics": []string{"git", "go", "gitea"},
+		}).
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.ReplaceTopics(context.Background(), "go-gitea/gitea", []string{"git", "go", "gitea"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoLanguageList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/languages").
+		Reply(200).
+		Type("application/json").
+		File("testdata/languages.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.ListLanguages(context.Background(), "go-gitea/gitea")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Language{}
+	raw, _ := ioutil.ReadFile("testdata/languages.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestHookFind(t *testing.T) {
 	defer gock.Off()
 
@@ -230,6 +307,29 @@ func TestHookDelete(t *testing.T) {
 	}
 }
 
+func TestHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/hooks/20/tests").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.PingHook(context.Background(), "go-gitea/gitea", "20")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHookDeliveryList(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Repositories.ListHookDeliveries(context.Background(), "go-gitea/gitea", "20", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
@@ -259,6 +359,26 @@ func TestHookEvents(t *testing.T) {
 			in:  scm.HookEvents{PullRequest: true},
 			out: []string{"pull_request"},
 		},
+		{
+			in:  scm.HookEvents{PullRequestReview: true},
+			out: []string{"pull_request_review_approved", "pull_request_review_rejected", "pull_request_review_comment"},
+		},
+		{
+			in:  scm.HookEvents{Release: true},
+			out: []string{"release"},
+		},
+		{
+			in:  scm.HookEvents{Status: true},
+			out: []string{"status"},
+		},
+		{
+			in:  scm.HookEvents{Repository: true},
+			out: []string{"repository"},
+		},
+		{
+			in:  scm.HookEvents{Wiki: true},
+			out: []string{"wiki"},
+		},
 		{
 			in: scm.HookEvents{
 				Branch:             true,
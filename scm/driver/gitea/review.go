@@ -6,6 +6,8 @@ package gitea
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +17,101 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d", repo, number, id)
+	out := new(review)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*review{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewList(out), res, err
 }
 
+// Create creates a pull request review. If the input
+// includes a file path, the body is added as a review
+// comment on the line of the file.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := &reviewInput{
+		Event:    "COMMENT",
+		CommitID: input.Sha,
+	}
+	if input.Path == "" {
+		in.Body = input.Body
+	} else {
+		in.Comments = []*reviewCommentInput{
+			{
+				Path:        input.Path,
+				Body:        input.Body,
+				NewPosition: input.Line,
+			},
+		}
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea pull request review object.
+	review struct {
+		ID          int       `json:"id"`
+		User        user      `json:"user"`
+		Body        string    `json:"body"`
+		CommitID    string    `json:"commit_id"`
+		State       string    `json:"state"`
+		HTMLURL     string    `json:"html_url"`
+		SubmittedAt time.Time `json:"submitted_at"`
+	}
+
+	// gitea pull request review request object.
+	reviewInput struct {
+		Body     string                `json:"body,omitempty"`
+		CommitID string                `json:"commit_id,omitempty"`
+		Event    string                `json:"event"`
+		Comments []*reviewCommentInput `json:"comments,omitempty"`
+	}
+
+	// gitea pull request review comment request object.
+	reviewCommentInput struct {
+		Path        string `json:"path"`
+		Body        string `json:"body"`
+		NewPosition int    `json:"new_position"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func convertReviewList(from []*review) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		to = append(to, convertReview(v))
+	}
+	return to
+}
+
+func convertReview(from *review) *scm.Review {
+	return &scm.Review{
+		ID:      from.ID,
+		Body:    from.Body,
+		Sha:     from.CommitID,
+		Link:    from.HTMLURL,
+		Author:  *convertUser(&from.User),
+		Created: from.SubmittedAt,
+		Updated: from.SubmittedAt,
+	}
 }
@@ -6,39 +6,122 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1/reviews/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.Find(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Reviews.Find(context.Background(), "go-gitea/gitea", 1, 3)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1/reviews").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.List(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Reviews.List(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/reviews").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"commit_id": "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"event":     "COMMENT",
+			"comments": []map[string]interface{}{
+				{"path": "README.md", "body": "looks good to me", "new_position": 2},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	input := &scm.ReviewInput{
+		Body: "looks good to me",
+		Sha:  "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		Path: "README.md",
+		Line: 2,
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.Create(context.Background(), "go-gitea/gitea", 1, nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Reviews.Create(context.Background(), "go-gitea/gitea", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/pulls/1/reviews/3").
+		Reply(204).
+		Type("application/json")
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Reviews.Delete(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Reviews.Delete(context.Background(), "go-gitea/gitea", 1, 3)
+	if err != nil {
+		t.Error(err)
 	}
 }
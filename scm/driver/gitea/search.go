@@ -0,0 +1,117 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.RepositorySearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	params := url.Values{}
+	// gitea cannot combine a keyword and a topic search, in
+	// which case the topic takes precedence. gitea does not
+	// support filtering repositories by language.
+	if opts.Topic != "" {
+		params.Set("q", opts.Topic)
+		params.Set("topic", "true")
+	} else if opts.Query != "" {
+		params.Set("q", opts.Query)
+	}
+	if opts.Org != "" {
+		// gitea filters repositories by owner id, which
+		// requires looking up the organization first.
+		org := new(org)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("api/v1/orgs/%s", opts.Org), nil, org)
+		if err != nil {
+			return nil, res, err
+		}
+		params.Set("uid", strconv.Itoa(org.ID))
+		params.Set("exclusive", "true")
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("api/v1/repos/search?%s", params.Encode())
+	out := new(repositorySearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepositoryList(out.Data), res, err
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.CodeSearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.IssueSearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	params := url.Values{}
+	if opts.Query != "" {
+		params.Set("q", opts.Query)
+	}
+	if opts.PullRequest {
+		params.Set("type", "pulls")
+	} else {
+		params.Set("type", "issues")
+	}
+	if opts.Open && opts.Closed {
+		params.Set("state", "all")
+	} else if opts.Closed {
+		params.Set("state", "closed")
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("before", opts.Until.UTC().Format(time.RFC3339))
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+
+	// gitea only supports filtering by author when
+	// searching the issues of a single repository.
+	var path string
+	if opts.Repo != "" {
+		if opts.Author != "" {
+			params.Set("created_by", opts.Author)
+		}
+		path = fmt.Sprintf("api/v1/repos/%s/issues?%s", opts.Repo, params.Encode())
+	} else {
+		if opts.Org != "" {
+			params.Set("owner", opts.Org)
+		}
+		path = fmt.Sprintf("api/v1/repos/issues/search?%s", params.Encode())
+	}
+	out := []*issue{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueList(out), res, err
+}
+
+//
+// native data structures
+//
+
+type repositorySearchResult struct {
+	OK   bool          `json:"ok"`
+	Data []*repository `json:"data"`
+}
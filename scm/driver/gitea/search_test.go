@@ -0,0 +1,106 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/orgs/gogits").
+		Reply(200).
+		Type("application/json").
+		File("testdata/organization.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/search").
+		MatchParam("q", "gitea").
+		MatchParam("uid", "1").
+		MatchParam("exclusive", "true").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_repos.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, res, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{
+		Query: "gitea",
+		Org:   "gogits",
+		Page:  1,
+		Size:  30,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/search_repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchCode(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Search.Code(context.Background(), scm.CodeSearchOptions{Query: "main"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues").
+		MatchParam("q", "bug").
+		MatchParam("type", "issues").
+		MatchParam("state", "closed").
+		MatchParam("labels", "bug").
+		MatchParam("created_by", "gogs").
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_issues.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{
+		Query:  "bug",
+		Repo:   "go-gitea/gitea",
+		Author: "gogs",
+		Labels: []string{"bug"},
+		Closed: true,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/search_issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -7,6 +7,9 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -33,17 +36,121 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// FindByEmail searches for the user account with a matching
+// email address. Gitea only matches email addresses that are
+// visible to the authenticated user.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/users/search?q=%s", url.QueryEscape(email))
+	out := new(userSearch)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Data) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertUser(out.Data[0]), res, nil
+}
+
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/emails?%s", encodeListOptions(opts))
+	out := []*email{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertEmailList(out), res, err
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{
+		Key: input.Key,
+	}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/users/%s/repos?%s", login, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 //
 // native data structures
 //
 
 type user struct {
-	ID       int    `json:"id"`
-	Login    string `json:"login"`
-	Username string `json:"username"`
-	Fullname string `json:"full_name"`
+	ID       int       `json:"id"`
+	Login    string    `json:"login"`
+	Username string    `json:"username"`
+	Fullname string    `json:"full_name"`
+	Email    string    `json:"email"`
+	Avatar   string    `json:"avatar_url"`
+	HTMLURL  string    `json:"html_url"`
+	IsAdmin  bool      `json:"is_admin"`
+	Created  time.Time `json:"created"`
+}
+
+type userSearch struct {
+	OK   bool    `json:"ok"`
+	Data []*user `json:"data"`
+}
+
+type email struct {
 	Email    string `json:"email"`
-	Avatar   string `json:"avatar_url"`
+	Verified bool   `json:"verified"`
+	Primary  bool   `json:"primary"`
+}
+
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+type gpgKey struct {
+	ID      int       `json:"id"`
+	KeyID   string    `json:"key_id"`
+	Key     string    `json:"public_key"`
+	Created time.Time `json:"created_at"`
+	Expires time.Time `json:"expires_at"`
+	Emails  []struct {
+		Email string `json:"email"`
+	} `json:"emails"`
+}
+
+type gpgKeyInput struct {
+	Key string `json:"armored_public_key"`
 }
 
 //
@@ -52,10 +159,15 @@ type user struct {
 
 func convertUser(src *user) *scm.User {
 	return &scm.User{
-		Login:  userLogin(src),
-		Avatar: src.Avatar,
-		Email:  src.Email,
-		Name:   src.Fullname,
+		ID:      strconv.Itoa(src.ID),
+		Login:   userLogin(src),
+		Avatar:  src.Avatar,
+		Email:   src.Email,
+		Name:    src.Fullname,
+		Link:    src.HTMLURL,
+		Type:    scm.UserTypeUser,
+		Admin:   src.IsAdmin,
+		Created: src.Created,
 	}
 }
 
@@ -65,3 +177,54 @@ func userLogin(src *user) string {
 	}
 	return src.Login
 }
+
+func convertEmailList(src []*email) []*scm.Email {
+	dst := []*scm.Email{}
+	for _, v := range src {
+		dst = append(dst, &scm.Email{
+			Address:  v.Email,
+			Primary:  v.Primary,
+			Verified: v.Verified,
+		})
+	}
+	return dst
+}
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:      src.ID,
+		Title:   src.Title,
+		Key:     src.Key,
+		Created: src.Created,
+	}
+}
+
+func convertGPGKeyList(src []*gpgKey) []*scm.GPGKey {
+	dst := []*scm.GPGKey{}
+	for _, v := range src {
+		dst = append(dst, convertGPGKey(v))
+	}
+	return dst
+}
+
+func convertGPGKey(src *gpgKey) *scm.GPGKey {
+	dst := &scm.GPGKey{
+		ID:      src.ID,
+		KeyID:   src.KeyID,
+		Key:     src.Key,
+		Created: src.Created,
+		Expires: src.Expires,
+	}
+	for _, v := range src.Emails {
+		dst.Emails = append(dst.Emails, v.Email)
+	}
+	return dst
+}
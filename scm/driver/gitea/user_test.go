@@ -85,3 +85,221 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Want email %s, got %s", want, got)
 	}
 }
+
+func TestUserFindByEmail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/users/search").
+		MatchParam("q", "jane@example.com").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_search.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.FindByEmail(context.Background(), "jane@example.com")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.User)
+	raw, _ := ioutil.ReadFile("testdata/user.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserFindByEmail_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/users/search").
+		MatchParam("q", "john@example.com").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"ok":true,"data":[]}`)
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Users.FindByEmail(context.Background(), "john@example.com")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want Not Found error, got %v", err)
+	}
+}
+
+func TestUserListEmails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/emails").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_emails.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListEmails(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Email{}
+	raw, _ := ioutil.ReadFile("testdata/user_emails.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListKeys(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/user_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserCreateKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/user/keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"title": "laptop",
+			"key":   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDAJQeTLeCVRZ4XcG1gSzPbSYaHNsIadxr0Yg0P1d9eI",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_key.json")
+
+	input := &scm.KeyInput{
+		Title: "laptop",
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDAJQeTLeCVRZ4XcG1gSzPbSYaHNsIadxr0Yg0P1d9eI",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/user_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListGPGKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user/gpg_keys").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_gpg_keys.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListGPGKeys(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserCreateGPGKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/user/gpg_keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"armored_public_key": "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_gpg_key.json")
+
+	input := &scm.GPGKeyInput{
+		Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.CreateGPGKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.GPGKey)
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/users/jcitizen/repos").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.ListRepositories(context.Background(), "jcitizen", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -24,6 +24,23 @@ func encodeListOptions(opts scm.ListOptions) string {
 	return params.Encode()
 }
 
+func encodeCommitListOptions(opts scm.CommitListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	if opts.Ref != "" {
+		params.Set("sha", opts.Ref)
+	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	return params.Encode()
+}
+
 func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -50,9 +67,19 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
-	} else if opts.Closed {
+	} else if opts.Closed || opts.Merged {
 		params.Set("state", "closed")
 	}
+	// gitea sorts by creation date in descending order
+	// by default, which is omitted from the parameters.
+	switch {
+	case opts.Sort == scm.SortByCreated && opts.Direction == scm.SortAsc:
+		params.Set("sort", "oldest")
+	case opts.Sort == scm.SortByUpdated && opts.Direction == scm.SortAsc:
+		params.Set("sort", "leastupdate")
+	case opts.Sort == scm.SortByUpdated:
+		params.Set("sort", "recentupdate")
+	}
 	return params.Encode()
 }
 
@@ -58,6 +58,14 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// organization hooks deliver the same payload as
+		// repository hooks.
+		{
+			event:  "push",
+			before: "testdata/webhooks/push_org.json",
+			after:  "testdata/webhooks/push_org.json.golden",
+			obj:    new(scm.PushHook),
+		},
 		// issue hooks
 		{
 			event:  "issues",
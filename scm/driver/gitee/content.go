@@ -85,7 +85,7 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 }
 
 func (s *contentService) List(ctx context.Context, repo, path, ref string, opts scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
-	endpoint := fmt.Sprintf("api/v5/repos/%s/git/trees/%s", encode(repo), url.QueryEscape(path), ref, encodeListOptions(opts))
+	endpoint := fmt.Sprintf("api/v5/repos/%s/git/trees/%s?%s", encode(repo), url.QueryEscape(ref), encodeListOptions(opts))
 	out := []*object{}
 	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
 	return convertContentInfoList(out), res, err
@@ -7,6 +7,7 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -82,6 +83,14 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type branch struct {
 	Name   string `json:"name"`
 	Commit struct {
@@ -48,7 +48,7 @@ func TestClient_Base(t *testing.T) {
 
 func TestClient_Default(t *testing.T) {
 	client := NewDefault()
-	if got, want := client.BaseURL.String(), "https://gitlab.com/"; got != want {
+	if got, want := client.BaseURL.String(), "https://gitee.com/"; got != want {
 		t.Errorf("Want Client URL %q, got %q", want, got)
 	}
 }
@@ -83,18 +83,16 @@ func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm
 	return res, err
 }
 
+// Lock is not supported. The Gitee v5 API does not provide an
+// endpoint to lock the discussion of an issue.
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	//path := fmt.Sprintf("api/v5/repos/%s/issues/%d?discussion_locked=true", encode(repo), number)
-	//res, err := s.client.do(ctx, "PUT", path, nil, nil)
-	//gitee好像没有关闭评论的功能及接口
-	return nil, nil
+	return nil, scm.ErrNotSupported
 }
 
+// Unlock is not supported. The Gitee v5 API does not provide an
+// endpoint to unlock the discussion of an issue.
 func (s *issueService) Unlock(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	//path := fmt.Sprintf("api/v5/repos/%s/issues/%d?discussion_locked=false", encode(repo), number)
-	//res, err := s.client.do(ctx, "PUT", path, nil, nil)
-	//gitee好像没有关闭评论的功能及接口
-	return nil, nil
+	return nil, scm.ErrNotSupported
 }
 
 type issue struct {
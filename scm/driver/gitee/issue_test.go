@@ -257,43 +257,17 @@ func TestIssueClose(t *testing.T) {
 }
 
 func TestIssueLock(t *testing.T) {
-	defer gock.Off()
-
-	gock.New("https://gitlab.com").
-		Put("/api/v4/projects/diaspora/diaspora/issues/1").
-		MatchParam("discussion_locked", "true").
-		Reply(204).
-		Type("application/json").
-		SetHeaders(mockHeaders)
-
 	client := NewDefault()
-	res, err := client.Issues.Lock(context.Background(), "diaspora/diaspora", 1)
-	if err != nil {
-		t.Error(err)
-		return
+	_, err := client.Issues.Lock(context.Background(), "diaspora/diaspora", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
 	}
-
-	t.Run("Request", testRequest(res))
-	t.Run("Rate", testRate(res))
 }
 
 func TestIssueUnlock(t *testing.T) {
-	defer gock.Off()
-
-	gock.New("https://gitlab.com").
-		Put("/api/v4/projects/diaspora/diaspora/issues/1").
-		MatchParam("discussion_locked", "false").
-		Reply(204).
-		Type("application/json").
-		SetHeaders(mockHeaders)
-
 	client := NewDefault()
-	res, err := client.Issues.Unlock(context.Background(), "diaspora/diaspora", 1)
-	if err != nil {
-		t.Error(err)
-		return
+	_, err := client.Issues.Unlock(context.Background(), "diaspora/diaspora", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
 	}
-
-	t.Run("Request", testRequest(res))
-	t.Run("Rate", testRate(res))
 }
@@ -6,6 +6,8 @@ package gitee
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
 )
@@ -15,23 +17,81 @@ type organizationService struct {
 }
 
 func (s *organizationService) Find(ctx context.Context, name string) (*scm.Organization, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/orgs/%s", name)
+	out := new(organization)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertOrganization(out), res, err
 }
 
 func (s *organizationService) FindMembership(ctx context.Context, name, username string) (*scm.Membership, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/orgs/%s/memberships/%s", name, username)
+	out := new(membership)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertMembership(out), res, err
 }
 
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
+	path := fmt.Sprintf("api/v5/user/orgs?%s", encodeListOptions(opts))
+	out := []*organization{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertOrganizationList(out), res, err
+}
+
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 type organization struct {
+	Login  string      `json:"login"`
 	Name   string      `json:"name"`
-	Path   string      `json:"path"`
 	Avatar null.String `json:"avatar_url"`
 }
 
+type membership struct {
+	Active bool   `json:"active"`
+	Role   string `json:"role"`
+}
+
 func convertOrganizationList(from []*organization) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from {
@@ -42,7 +102,21 @@ func convertOrganizationList(from []*organization) []*scm.Organization {
 
 func convertOrganization(from *organization) *scm.Organization {
 	return &scm.Organization{
-		Name:   from.Path,
+		Name:   from.Login,
 		Avatar: from.Avatar.String,
 	}
 }
+
+func convertMembership(from *membership) *scm.Membership {
+	to := new(scm.Membership)
+	to.Active = from.Active
+	switch from.Role {
+	case "admin":
+		to.Role = scm.RoleAdmin
+	case "member":
+		to.Role = scm.RoleMember
+	default:
+		to.Role = scm.RoleUndefined
+	}
+	return to
+}
@@ -19,22 +19,52 @@ import (
 func TestOrganizationFind(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/groups/Twitter").
+	gock.New("https://gitee.com").
+		Get("/api/v5/orgs/twitter").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/group.json")
+		File("testdata/org.json")
 
 	client := NewDefault()
-	got, res, err := client.Organizations.Find(context.Background(), "Twitter")
+	got, res, err := client.Organizations.Find(context.Background(), "twitter")
 	if err != nil {
 		t.Error(err)
 		return
 	}
 
 	want := new(scm.Organization)
-	raw, _ := ioutil.ReadFile("testdata/group.json.golden")
+	raw, _ := ioutil.ReadFile("testdata/org.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestOrganizationFindMembership(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/orgs/twitter/memberships/john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/membership.json")
+
+	client := NewDefault()
+	got, res, err := client.Organizations.FindMembership(context.Background(), "twitter", "john_smith")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Membership)
+	raw, _ := ioutil.ReadFile("testdata/membership.json.golden")
 	json.Unmarshal(raw, want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -49,15 +79,15 @@ func TestOrganizationFind(t *testing.T) {
 func TestOrganizationList(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/groups").
+	gock.New("https://gitee.com").
+		Get("/api/v5/user/orgs").
 		MatchParam("per_page", "30").
 		MatchParam("page", "1").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		SetHeaders(mockPageHeaders).
-		File("testdata/groups.json")
+		File("testdata/orgs.json")
 
 	client := NewDefault()
 	got, res, err := client.Organizations.List(context.Background(), scm.ListOptions{Size: 30, Page: 1})
@@ -67,7 +97,7 @@ func TestOrganizationList(t *testing.T) {
 	}
 
 	want := []*scm.Organization{}
-	raw, _ := ioutil.ReadFile("testdata/groups.json.golden")
+	raw, _ := ioutil.ReadFile("testdata/orgs.json.golden")
 	json.Unmarshal(raw, &want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
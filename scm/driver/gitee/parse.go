@@ -0,0 +1,114 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverGitee, ParseURL)
+}
+
+// ParseURL parses a Gitee web or api url into a resource.
+// Only urls on gitee.com are recognized.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if u.Hostname() != "gitee.com" {
+		return nil, scm.ErrUnknownURL
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) > 2 && parts[0] == "api" && parts[1] == "v5" && parts[2] == "repos" {
+		return parseAPI(u, parts[3:])
+	}
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGitee,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"),
+	}
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pulls":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commit":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) >= 2 && rest[0] == "tree":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+	case len(rest) > 2 && (rest[0] == "blob" || rest[0] == "blame"):
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+		res.Lines = scm.ParseLineRange(u.Fragment)
+	case len(rest) == 3 && rest[0] == "releases" && rest[1] == "tag":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[2]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// parseAPI parses the path segments of a Gitee api url
+// that follow the api/v5/repos/ prefix.
+func parseAPI(u *url.URL, parts []string) (*scm.Resource, error) {
+	if len(parts) < 2 {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGitee,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + parts[1],
+	}
+	var err error
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pulls":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) > 1 && rest[0] == "contents":
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = u.Query().Get("ref")
+		res.Path = strings.Join(rest[1:], "/")
+	case len(rest) == 3 && rest[0] == "releases" && rest[1] == "tags":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[2]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
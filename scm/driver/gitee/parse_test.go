@@ -0,0 +1,72 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://gitee.com/kit101/drone-yml-test",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://gitee.com/kit101/drone-yml-test/pulls/7",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 7},
+		},
+		{
+			url:  "https://gitee.com/kit101/drone-yml-test/commit/e3c0ff4d5cef439ea11b30866fb1ed79b420801d",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "e3c0ff4d5cef439ea11b30866fb1ed79b420801d"},
+		},
+		{
+			url:  "https://gitee.com/kit101/drone-yml-test/blob/master/README.md#L10-L20",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://gitee.com/kit101/drone-yml-test/releases/tag/v1.0.0",
+			want: scm.Resource{Kind: scm.ResourceKindRelease, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://gitee.com/api/v5/repos/kit101/drone-yml-test/pulls/7",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 7},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverGitee
+		want.Host = "gitee.com"
+		want.Repo = "kit101/drone-yml-test"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://github.com/kit101/drone-yml-test",
+		"https://gitee.com/kit101",
+		"https://gitee.com/kit101/drone-yml-test/issues/I4ABCD",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -44,15 +45,19 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertChangeList(out.Changes), res, err
 }
 
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/comments", encode(repo), index, encodeListOptions(opts))
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/comments?%s", encode(repo), index, encodeListOptions(opts))
 	out := []*issueComment{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertIssueCommentList(out), res, err
 }
 
 func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/commits", encode(repo), number, nil)
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/commits", encode(repo), number)
 	out := []*commit{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertCommitList(out), res, err
@@ -101,9 +106,10 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.
 }
 
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	//path := fmt.Sprintf("api/v5/repos/%s/pulls/%d?state_event=closed", encode(repo), number)
-	//res, err := s.client.do(ctx, "PUT", path, nil, nil)
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d", repo, number)
+	in := prStateInput{State: "closed"}
+	res, err := s.client.do(ctx, "PATCH", path, in, nil)
+	return res, err
 }
 
 type pr struct {
@@ -157,6 +163,10 @@ type prCreate struct {
 	PruneSourceBranch bool   `json:"prune_source_branch"`
 }
 
+type prStateInput struct {
+	State string `json:"state"`
+}
+
 type changes struct {
 	Changes []*change
 }
@@ -166,6 +176,7 @@ type change struct {
 	Additions int    `json:"additions"`
 	Deletions int    `json:"deletions"`
 	Status    string `json:"status"`
+	Patch     string `json:"patch"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -187,7 +198,7 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Number: from.Number,
 		Title:  from.Title,
 		Body:   from.Body,
-		Sha:    nil,
+		Sha:    "",
 		Ref:    fmt.Sprintf("refs/merge-requests/%d/head", from.Number),
 		Source: from.Head.Ref,
 		Target: from.Base.Ref,
@@ -219,6 +230,10 @@ func convertChange(from *change) *scm.Change {
 		Added:   from.Additions == 1,
 		Deleted: from.Deletions == 1,
 		Renamed: from.Status == "modified",
+
+		Patch:     from.Patch,
+		Additions: from.Additions,
+		Deletions: from.Deletions,
 	}
 	if to.Path == "" {
 		to.Path = from.FileName
@@ -132,9 +132,10 @@ func TestPullMerge(t *testing.T) {
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
-		MatchParam("state_event", "closed").
+	gock.New("https://gitee.com").
+		Patch("/api/v5/repos/diaspora/diaspora/pulls/1347").
+		MatchType("json").
+		JSON(map[string]string{"state": "closed"}).
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders)
@@ -3,6 +3,7 @@ package gitee
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -12,28 +13,24 @@ type releaseService struct {
 }
 
 type release struct {
-	ID          int    `json:"id"`
-	Title       string `json:"name"`
-	Description string `json:"description"`
-	Tag         string `json:"tag_name"`
-	Assets      []struct {
+	ID              int       `json:"id"`
+	Title           string    `json:"name"`
+	Description     string    `json:"body"`
+	Tag             string    `json:"tag_name"`
+	TargetCommitish string    `json:"target_commitish"`
+	Prerelease      bool      `json:"prerelease"`
+	Created         time.Time `json:"created_at"`
+	Assets          []struct {
 		BrowerDownloadUrl string `json:"browser_download_url"`
-	}
-	TargetCommitish string `json:"target_commitish"`
-	Prerelease      bool   `json:"prerelease"`
+	} `json:"assets"`
 }
 
 type releaseInput struct {
-	Title       string `json:"name"`
-	Description string `json:"description"`
-	Tag         string `json:"tag_name"`
-}
-
-type releasePatch struct {
-	TagName    string `json:"tag_name"`
-	Name       string `json:"name"`
-	Body       string `json:"body"`
-	Prerelease bool   `json:"prerelease"`
+	TagName         string `json:"tag_name"`
+	Name            string `json:"name"`
+	Body            string `json:"body"`
+	Prerelease      bool   `json:"prerelease"`
+	TargetCommitish string `json:"target_commitish"`
 }
 
 func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
@@ -44,25 +41,27 @@ func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Re
 }
 
 func (s *releaseService) FindByTag(ctx context.Context, repo string, tag string) (*scm.Release, *scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/releases/tags/%s", encode(repo), tag)
+	path := fmt.Sprintf("api/v5/repos/%s/releases/tags/%s", repo, tag)
 	out := new(release)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	return convertRelease(out), res, err
 }
 
 func (s *releaseService) List(ctx context.Context, repo string, opts scm.ReleaseListOptions) ([]*scm.Release, *scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/releases", encode(repo))
+	path := fmt.Sprintf("api/v5/repos/%s/releases?%s", repo, encodeReleaseListOptions(opts))
 	out := []*release{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertReleaseList(out), res, err
 }
 
 func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/releases", encode(repo))
+	path := fmt.Sprintf("api/v5/repos/%s/releases", repo)
 	in := &releaseInput{
-		Title:       input.Title,
-		Description: input.Description,
-		Tag:         input.Tag,
+		TagName:         input.Tag,
+		Name:            input.Title,
+		Body:            input.Description,
+		Prerelease:      input.Prerelease,
+		TargetCommitish: input.Commitish,
 	}
 	out := new(release)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -70,23 +69,27 @@ func (s *releaseService) Create(ctx context.Context, repo string, input *scm.Rel
 }
 
 func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
-	path := fmt.Sprintf("api/v5/repos/%s/released/%d", repo, id)
+	path := fmt.Sprintf("api/v5/repos/%s/releases/%d", repo, id)
 	res, err := s.client.do(ctx, "DELETE", path, nil, nil)
 	return res, err
 }
 
 func (s *releaseService) DeleteByTag(ctx context.Context, repo string, tag string) (*scm.Response, error) {
-
-	return nil, scm.ErrNotSupported
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return res, err
+	}
+	return s.Delete(ctx, repo, rel.ID)
 }
 
 func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	// this could be implemented by List and filter but would be to expensive
 	path := fmt.Sprintf("api/v5/repos/%s/releases/%d", repo, id)
-	in := releasePatch{
-		TagName: input.Tag,
-		Name:    input.Title,
-		Body:    input.Description,
+	in := &releaseInput{
+		TagName:         input.Tag,
+		Name:            input.Title,
+		Body:            input.Description,
+		Prerelease:      input.Prerelease,
+		TargetCommitish: input.Commitish,
 	}
 	out := new(release)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
@@ -94,8 +97,11 @@ func (s *releaseService) Update(ctx context.Context, repo string, id int, input
 }
 
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-
-	return nil, nil, scm.ErrNotSupported
+	rel, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.Update(ctx, repo, rel.ID, input)
 }
 
 func convertReleaseList(from []*release) []*scm.Release {
@@ -107,14 +113,18 @@ func convertReleaseList(from []*release) []*scm.Release {
 }
 
 func convertRelease(from *release) *scm.Release {
-	return &scm.Release{
+	to := &scm.Release{
 		ID:          from.ID,
 		Title:       from.Title,
 		Description: from.Description,
-		Link:        from.Assets[0].BrowerDownloadUrl,
 		Tag:         from.Tag,
 		Commitish:   from.TargetCommitish,
 		Draft:       false, // not supported by gitee
 		Prerelease:  from.Prerelease,
+		Created:     from.Created,
+	}
+	if len(from.Assets) != 0 {
+		to.Link = from.Assets[0].BrowerDownloadUrl
 	}
+	return to
 }
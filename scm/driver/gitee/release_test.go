@@ -12,18 +12,18 @@ import (
 	"github.com/h2non/gock"
 )
 
-func TestReleaseFindByTag(t *testing.T) {
+func TestReleaseFind(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/projects/diaspora/diaspora/releases/v1.0.1").
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases/1").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		File("testdata/release.json")
 
 	client := NewDefault()
-	got, res, err := client.Releases.FindByTag(context.Background(), "diaspora/diaspora", "v1.0.1")
+	got, res, err := client.Releases.Find(context.Background(), "diaspora/diaspora", 1)
 	if err != nil {
 		t.Error(err)
 		return
@@ -31,18 +31,41 @@ func TestReleaseFindByTag(t *testing.T) {
 
 	want := new(scm.Release)
 	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
-	err = json.Unmarshal(raw, want)
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases/tags/v1.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	client := NewDefault()
+	got, res, err := client.Releases.FindByTag(context.Background(), "diaspora/diaspora", "v1.0")
 	if err != nil {
 		t.Error(err)
 		return
 	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
-
-		data, _ := json.Marshal(got)
-		t.Log("got JSON:")
-		t.Log(string(data))
 	}
 
 	t.Run("Request", testRequest(res))
@@ -52,15 +75,17 @@ func TestReleaseFindByTag(t *testing.T) {
 func TestReleaseList(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/projects/diaspora/diaspora/releases").
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		File("testdata/releases.json")
 
 	client := NewDefault()
-	got, res, err := client.Releases.List(context.Background(), "diaspora/diaspora", scm.ReleaseListOptions{})
+	got, res, err := client.Releases.List(context.Background(), "diaspora/diaspora", scm.ReleaseListOptions{Page: 1, Size: 30})
 	if err != nil {
 		t.Error(err)
 		return
@@ -68,18 +93,11 @@ func TestReleaseList(t *testing.T) {
 
 	want := []*scm.Release{}
 	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
-	err = json.Unmarshal(raw, &want)
-	if err != nil {
-		t.Error(err)
-		return
-	}
+	json.Unmarshal(raw, &want)
+
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
-
-		data, _ := json.Marshal(got)
-		t.Log("got JSON:")
-		t.Log(string(data))
 	}
 
 	t.Run("Request", testRequest(res))
@@ -89,10 +107,17 @@ func TestReleaseList(t *testing.T) {
 func TestReleaseCreate(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Post("/api/v4/projects/diaspora/diaspora/releases").
-		File("testdata/release_create.json").
-		Reply(200).
+	gock.New("https://gitee.com").
+		Post("/api/v5/repos/diaspora/diaspora/releases").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"tag_name":         "v1.0",
+			"name":             "v1.0",
+			"body":             "Tracking release for version 1.0",
+			"prerelease":       false,
+			"target_commitish": "master",
+		}).
+		Reply(201).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		File("testdata/release.json")
@@ -102,6 +127,7 @@ func TestReleaseCreate(t *testing.T) {
 		Title:       "v1.0",
 		Description: "Tracking release for version 1.0",
 		Tag:         "v1.0",
+		Commitish:   "master",
 	}
 
 	got, res, err := client.Releases.Create(context.Background(), "diaspora/diaspora", input)
@@ -112,18 +138,11 @@ func TestReleaseCreate(t *testing.T) {
 
 	want := new(scm.Release)
 	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
-	err = json.Unmarshal(raw, want)
-	if err != nil {
-		t.Error(err)
-		return
-	}
+	json.Unmarshal(raw, want)
+
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
-
-		data, _ := json.Marshal(got)
-		t.Log("got JSON:")
-		t.Log(string(data))
 	}
 
 	t.Run("Request", testRequest(res))
@@ -133,9 +152,23 @@ func TestReleaseCreate(t *testing.T) {
 func TestReleaseUpdateByTag(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Put("/api/v4/projects/diaspora/diaspora/releases/v1.0").
-		File("testdata/release_create.json").
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases/tags/v1.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	gock.New("https://gitee.com").
+		Patch("/api/v5/repos/diaspora/diaspora/releases/1").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"tag_name":         "v1.0",
+			"name":             "v1.0",
+			"body":             "Tracking release for version 1.0",
+			"prerelease":       false,
+			"target_commitish": "master",
+		}).
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -146,6 +179,7 @@ func TestReleaseUpdateByTag(t *testing.T) {
 		Title:       "v1.0",
 		Description: "Tracking release for version 1.0",
 		Tag:         "v1.0",
+		Commitish:   "master",
 	}
 
 	got, res, err := client.Releases.UpdateByTag(context.Background(), "diaspora/diaspora", "v1.0", input)
@@ -156,11 +190,8 @@ func TestReleaseUpdateByTag(t *testing.T) {
 
 	want := new(scm.Release)
 	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
-	err = json.Unmarshal(raw, want)
-	if err != nil {
-		t.Error(err)
-		return
-	}
+	json.Unmarshal(raw, want)
+
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
 		t.Log(diff)
@@ -173,10 +204,17 @@ func TestReleaseUpdateByTag(t *testing.T) {
 func TestReleaseDeleteByTag(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Delete("/api/v4/projects/diaspora/diaspora/releases/v1.0").
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases/tags/v1.0").
 		Reply(200).
 		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/release.json")
+
+	gock.New("https://gitee.com").
+		Delete("/api/v5/repos/diaspora/diaspora/releases/1").
+		Reply(204).
+		Type("application/json").
 		SetHeaders(mockHeaders)
 
 	client := NewDefault()
@@ -186,6 +224,26 @@ func TestReleaseDeleteByTag(t *testing.T) {
 		return
 	}
 
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReleaseDeleteByTag_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/releases/tags/v9.9").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Not Found"}`)
+
+	client := NewDefault()
+	_, err := client.Releases.DeleteByTag(context.Background(), "diaspora/diaspora", "v9.9")
+	if err == nil {
+		t.Errorf("Want Not Found error")
+	}
+}
@@ -25,7 +25,15 @@ type repository struct {
 	SSHURL        string    `json:"ssh_url"`
 	HTTPURL       string    `json:"html_url"`
 	Namespace     namespace `json:"namespace"`
-	Permissions   struct {
+	Description   string    `json:"description"`
+	Language      string    `json:"language"`
+	Fork          bool      `json:"fork"`
+	Stars         int       `json:"stargazers_count"`
+	OpenIssues    int       `json:"open_issues_count"`
+	Parent        *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
+	Permissions struct {
 		Pull  bool `json:"pull"`
 		Push  bool `json:"push"`
 		Admin bool `json:"admin"`
@@ -76,6 +84,12 @@ type hookCreate struct {
 	MergeRequestsEvents bool   `json:"merge_requests_events"`
 }
 
+type projectLabel struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Ident string `json:"ident"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -115,11 +129,38 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm
 	return convertHookList(out), res, err
 }
 
+// ListStatus is not supported. The Gitee v5 API does not expose
+// commit statuses.
 func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListTopics returns the repository topics, which Gitee
+// calls project labels.
+func (s *repositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v5/repos/%s/project_labels", repo)
+	out := []*projectLabel{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertProjectLabelList(out), res, err
+}
 
+// ListLanguages is not supported. The Gitee v5 API only
+// reports the primary repository language.
+func (s *repositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) ReplaceTopics(ctx context.Context, repo string, topics []string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v5/repos/%s/project_labels", repo)
+	in := topics
+	if in == nil {
+		in = []string{}
+	}
+	out := []*projectLabel{}
+	res, err := s.client.do(ctx, "PUT", path, in, &out)
+	return convertProjectLabelList(out), res, err
+}
+
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	in := hookCreate{
 		Url:                 input.Target,
@@ -142,8 +183,9 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	return convertHook(out), res, err
 }
 
+// CreateStatus is not supported. The Gitee v5 API does not expose
+// commit statuses.
 func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
-
 	return nil, nil, scm.ErrNotSupported
 }
 
@@ -174,6 +216,39 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries is not supported. The Gitee v5 api does
+// not expose the webhook delivery history.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindHookDelivery is not supported. The Gitee v5 api does
+// not expose the webhook delivery history.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. The Gitee v5 api does not
+// expose the webhook delivery history.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v5/repos/%s/hooks/%s/tests", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// helper function to convert from the gitee project label
+// list to a list of topic names.
+func convertProjectLabelList(from []*projectLabel) []string {
+	to := []string{}
+	for _, v := range from {
+		to = append(to, v.Name)
+	}
+	return to
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -202,6 +277,14 @@ func convertRepository(from *repository) *scm.Repository {
 			Push:  from.Permissions.Push,
 			Admin: from.Permissions.Admin,
 		},
+		Description: from.Description,
+		Language:    from.Language,
+		Stars:       from.Stars,
+		OpenIssues:  from.OpenIssues,
+		Fork:        from.Fork,
+	}
+	if from.Parent != nil {
+		to.Parent = from.Parent.FullName
 	}
 	if path := from.Namespace.FullPath; path != "" {
 		to.Namespace = path
@@ -4,6 +4,86 @@
 //
 package gitee
 
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestRepositoryTopicList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/project_labels").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/project_labels.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListTopics(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/project_labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryTopicReplace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Put("/api/v5/repos/diaspora/diaspora/project_labels").
+		MatchType("json").
+		JSON([]string{"social", "ruby"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/project_labels.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ReplaceTopics(context.Background(), "diaspora/diaspora", []string{"social", "ruby"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/project_labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryLanguageList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListLanguages(context.Background(), "diaspora/diaspora")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 //
 //import (
 //	"context"
@@ -649,3 +729,24 @@ package gitee
 //		}
 //	}
 //}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Post("/api/v5/repos/diaspora/diaspora/hooks/1/tests").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.PingHook(context.Background(), "diaspora/diaspora", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
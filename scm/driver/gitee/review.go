@@ -6,6 +6,8 @@ package gitee
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +17,91 @@ type reviewService struct {
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/comments/%d", repo, id)
+	out := new(review)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := []*review{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewList(out), res, err
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/%d/comments", repo, number)
+	in := &reviewInput{
+		Body:     input.Body,
+		CommitID: input.Sha,
+		Path:     input.Path,
+		Position: input.Line,
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v5/repos/%s/pulls/comments/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type review struct {
+	ID       int    `json:"id"`
+	Body     string `json:"body"`
+	Path     string `json:"path"`
+	Position int    `json:"position"`
+	CommitID string `json:"commit_id"`
+	HTMLURL  string `json:"html_url"`
+	User     struct {
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"user"`
+	CommentType string    `json:"comment_type"`
+	Created     time.Time `json:"created_at"`
+	Updated     time.Time `json:"updated_at"`
+}
+
+type reviewInput struct {
+	Body     string `json:"body"`
+	CommitID string `json:"commit_id"`
+	Path     string `json:"path"`
+	Position int    `json:"position"`
+}
+
+// helper function to convert from the gitee pull request
+// comment list to the common review structure. Comments
+// that are not attached to a line of the diff are skipped.
+func convertReviewList(from []*review) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		if v.CommentType != "diff_comment" {
+			continue
+		}
+		to = append(to, convertReview(v))
+	}
+	return to
+}
+
+// helper function to convert from the gitee pull request
+// comment structure to the common review structure.
+func convertReview(from *review) *scm.Review {
+	return &scm.Review{
+		ID:   from.ID,
+		Body: from.Body,
+		Path: from.Path,
+		Sha:  from.CommitID,
+		Line: from.Position,
+		Link: from.HTMLURL,
+		Author: scm.User{
+			Login:  from.User.Login,
+			Name:   from.User.Name,
+			Avatar: from.User.AvatarURL,
+		},
+		Created: from.Created,
+		Updated: from.Updated,
+	}
 }
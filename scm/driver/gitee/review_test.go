@@ -6,39 +6,141 @@ package gitee
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Find(context.Background(), "diaspora/diaspora", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/pulls/comments/2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Find(context.Background(), "diaspora/diaspora", 1, 2)
+	if err != nil {
+		t.Error(err)
+		return
 	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
 
 func TestReviewList(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/repos/diaspora/diaspora/pulls/1/comments").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reviews.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.List(context.Background(), "diaspora/diaspora", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
 
 func TestReviewCreate(t *testing.T) {
-	service := new(reviewService)
-	_, _, err := service.Create(context.Background(), "diaspora/diaspora", 1, nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Post("/api/v5/repos/diaspora/diaspora/pulls/1/comments").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"body":      "Great stuff",
+			"commit_id": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"path":      "file1.txt",
+			"position":  1,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review.json")
+
+	input := &scm.ReviewInput{
+		Body: "Great stuff",
+		Sha:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Path: "file1.txt",
+		Line: 1,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.Create(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
 
 func TestReviewDelete(t *testing.T) {
-	service := new(reviewService)
-	_, err := service.Delete(context.Background(), "diaspora/diaspora", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Delete("/api/v5/repos/diaspora/diaspora/pulls/comments/2").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reviews.Delete(context.Background(), "diaspora/diaspora", 1, 2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
 	}
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
 }
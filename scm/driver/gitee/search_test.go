@@ -0,0 +1,33 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitee
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	_, _, err := NewDefault().Search.Repositories(context.Background(), scm.RepositorySearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestSearchCode(t *testing.T) {
+	_, _, err := NewDefault().Search.Code(context.Background(), scm.CodeSearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	_, _, err := NewDefault().Search.Issues(context.Background(), scm.IssueSearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -6,6 +6,10 @@ package gitee
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
 )
@@ -21,9 +25,13 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 }
 
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
-
-	return nil, nil, scm.ErrNotSupported
-
+	path := fmt.Sprintf("api/v5/users/%s", login)
+	out := new(user)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 404 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertUser(out), res, err
 }
 
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
@@ -31,18 +39,143 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// FindByEmail is not supported. The Gitee user search does
+// not match email addresses.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	out := []*email{}
+	res, err := s.client.do(ctx, "GET", "api/v5/emails", nil, &out)
+	return convertEmailList(out), res, err
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v5/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v5/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+// ListGPGKeys is not supported. Gitee does not provide an api
+// to manage gpg keys.
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CreateGPGKey is not supported. Gitee does not provide an api
+// to manage gpg keys.
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v5/users/%s/repos?%s", login, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
+	ID       int         `json:"id"`
 	Username string      `json:"login"`
 	Name     string      `json:"name"`
 	Email    null.String `json:"email"`
 	Avatar   string      `json:"avatar_url"`
+	HTMLURL  string      `json:"html_url"`
+	Type     string      `json:"type"`
+	Created  null.Time   `json:"created_at"`
+}
+
+type email struct {
+	Email string   `json:"email"`
+	State string   `json:"state"`
+	Scope []string `json:"scope"`
+}
+
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
 }
 
 func convertUser(from *user) *scm.User {
 	return &scm.User{
-		Avatar: from.Avatar,
-		Email:  from.Email.String,
-		Login:  from.Username,
-		Name:   from.Name,
+		ID:      strconv.Itoa(from.ID),
+		Avatar:  from.Avatar,
+		Email:   from.Email.String,
+		Login:   from.Username,
+		Name:    from.Name,
+		Link:    from.HTMLURL,
+		Type:    convertUserType(from.Type),
+		Created: from.Created.Time,
+	}
+}
+
+func convertUserType(from string) scm.UserType {
+	switch from {
+	case "User":
+		return scm.UserTypeUser
+	case "Bot":
+		return scm.UserTypeBot
+	case "Organization", "Enterprise":
+		return scm.UserTypeOrg
+	default:
+		return scm.UserTypeUndefined
+	}
+}
+
+func convertEmailList(from []*email) []*scm.Email {
+	to := []*scm.Email{}
+	for _, v := range from {
+		to = append(to, convertEmail(v))
+	}
+	return to
+}
+
+func convertEmail(from *email) *scm.Email {
+	to := &scm.Email{
+		Address:  from.Email,
+		Verified: from.State == "confirmed",
+	}
+	for _, scope := range from.Scope {
+		if scope == "primary" {
+			to.Primary = true
+		}
+	}
+	return to
+}
+
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      from.ID,
+		Title:   from.Title,
+		Key:     from.Key,
+		Created: from.Created,
 	}
 }
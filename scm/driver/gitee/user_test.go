@@ -20,8 +20,8 @@ import (
 func TestUserFind(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/user").
+	gock.New("https://gitee.com").
+		Get("/api/v5/user").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -52,13 +52,12 @@ func TestUserFind(t *testing.T) {
 func TestUserLoginFind(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/users").
-		MatchParam("search", "john_smith").
+	gock.New("https://gitee.com").
+		Get("/api/v5/users/john_smith").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/user_search.json")
+		File("testdata/user_login.json")
 
 	client := NewDefault()
 	got, res, err := client.Users.FindLogin(context.Background(), "john_smith")
@@ -68,7 +67,7 @@ func TestUserLoginFind(t *testing.T) {
 	}
 
 	want := new(scm.User)
-	raw, _ := ioutil.ReadFile("testdata/user_search.json.golden")
+	raw, _ := ioutil.ReadFile("testdata/user_login.json.golden")
 	json.Unmarshal(raw, &want)
 
 	if diff := cmp.Diff(got, want); diff != "" {
@@ -83,13 +82,12 @@ func TestUserLoginFind(t *testing.T) {
 func TestUserLoginFind_NotFound(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/users").
-		MatchParam("search", "jcitizen").
-		Reply(200).
+	gock.New("https://gitee.com").
+		Get("/api/v5/users/jcitizen").
+		Reply(404).
 		Type("application/json").
 		SetHeaders(mockHeaders).
-		File("testdata/user_search.json")
+		BodyString(`{"message":"Not Found"}`)
 
 	client := NewDefault()
 	_, _, err := client.Users.FindLogin(context.Background(), "jcitizen")
@@ -101,9 +99,8 @@ func TestUserLoginFind_NotFound(t *testing.T) {
 func TestUserLoginFind_NotAuthorized(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/users").
-		MatchParam("search", "jcitizen").
+	gock.New("https://gitee.com").
+		Get("/api/v5/users/jcitizen").
 		Reply(401).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -123,8 +120,8 @@ func TestUserLoginFind_NotAuthorized(t *testing.T) {
 func TestUserEmailFind(t *testing.T) {
 	defer gock.Off()
 
-	gock.New("https://gitlab.com").
-		Get("/api/v4/user").
+	gock.New("https://gitee.com").
+		Get("/api/v5/user").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -143,3 +140,113 @@ func TestUserEmailFind(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestUserFindByEmail(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Users.FindByEmail(context.Background(), "john@example.com")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestUserListEmails(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/emails").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_emails.json")
+
+	client := NewDefault()
+	got, res, err := client.Users.ListEmails(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Email{}
+	raw, _ := ioutil.ReadFile("testdata/user_emails.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestUserListKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Get("/api/v5/user/keys").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_keys.json")
+
+	client := NewDefault()
+	got, res, err := client.Users.ListKeys(context.Background(), scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/user_keys.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestUserCreateKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitee.com").
+		Post("/api/v5/user/keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"title": "laptop",
+			"key":   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDAJQeTLeCVRZ4XcG1gSzPbSYaHNsIadxr0Yg0P1d9eI",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_key.json")
+
+	input := &scm.KeyInput{
+		Title: "laptop",
+		Key:   "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIDAJQeTLeCVRZ4XcG1gSzPbSYaHNsIadxr0Yg0P1d9eI",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/user_key.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -58,6 +59,18 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Ref != "" {
 		params.Set("sha", opts.Ref)
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(scm.SearchTimeFormat))
+	}
 	return params.Encode()
 }
 
@@ -89,11 +102,45 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
+	} else if opts.Merged {
+		params.Set("state", "merged")
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	} else if opts.Open {
 		params.Set("state", "open")
 	}
+	if opts.Source != "" {
+		params.Set("head", opts.Source)
+	}
+	if opts.Target != "" {
+		params.Set("base", opts.Target)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if opts.Sort != scm.SortByDefault {
+		params.Set("sort", opts.Sort.String())
+	}
+	if opts.Direction != scm.SortDirectionDefault {
+		params.Set("direction", opts.Direction.String())
+	}
+	return params.Encode()
+}
+
+func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
 	return params.Encode()
 }
 
@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -81,6 +82,16 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Files), res, err
 }
 
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s", repo, ref)
+	return s.client.stream(ctx, path, diffMediaType(opts.Format), opts.MaxSize)
+}
+
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/compare/%s...%s", repo, source, target)
+	return s.client.stream(ctx, path, diffMediaType(opts.Format), opts.MaxSize)
+}
+
 type createBranch struct {
 	Ref string `json:"ref"`
 	Sha string `json:"sha"`
@@ -173,6 +173,33 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitCompareDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/compare/master...topic").
+		MatchHeader("Accept", "application/vnd.github.v3.diff").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.diff")
+
+	client := NewDefault()
+	rc, _, err := client.Git.CompareDiff(context.Background(), "octocat/hello-world", "master", "topic", scm.DiffOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
 	"strconv"
 	"strings"
@@ -44,6 +45,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Repositories = &RepositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -65,18 +67,30 @@ type wrapper struct {
 // do wraps the Client.Do function by creating the Request and
 // unmarshalling the response.
 func (c *wrapper) do(ctx context.Context, method, path string, in, out interface{}) (*scm.Response, error) {
+	return c.doMediaType(ctx, method, path, "", in, out)
+}
+
+// doMediaType is like do, but requests the response in the
+// provided media type using the Accept header.
+func (c *wrapper) doMediaType(ctx context.Context, method, path, mediaType string, in, out interface{}) (*scm.Response, error) {
 	req := &scm.Request{
 		Method: method,
 		Path:   path,
 	}
+	if mediaType != "" {
+		req.Header = map[string][]string{
+			"Accept": {mediaType},
+		}
+	}
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
 		buf := new(bytes.Buffer)
 		json.NewEncoder(buf).Encode(in)
-		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+		if req.Header == nil {
+			req.Header = map[string][]string{}
 		}
+		req.Header["Content-Type"] = []string{"application/json"}
 		req.Body = buf
 	}
 
@@ -121,6 +135,34 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream sends a GET request for the provided media type
+// and returns the response body without reading it. The
+// caller must close the returned reader.
+func (c *wrapper) stream(ctx context.Context, path, mediaType string, limit int64) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Header: map[string][]string{
+			"Accept": {mediaType},
+		},
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	res.ID = res.Header.Get("X-GitHub-Request-Id")
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status > 300 {
+		defer res.Body.Close()
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		return nil, res, err
+	}
+	return scm.LimitReadCloser(res.Body, limit), res, nil
+}
+
 // Error represents a Github error.
 type Error struct {
 	Message string `json:"message"`
@@ -36,6 +36,111 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/members", name)
+	return s.listMembers(ctx, path, "admin", opts)
+}
+
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams?%s", name, encodeListOptions(opts))
+	out := []*team{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/teams/%s/members", name, team)
+	return s.listMembers(ctx, path, "maintainer", opts)
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/repos?%s", name, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/memberships/%s", name, username)
+	in := &membershipInput{Role: "member"}
+	if role == scm.RoleAdmin {
+		in.Role = "admin"
+	}
+	out := new(membership)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertMembership(out), res, err
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/memberships/%s", name, username)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks?%s", name, encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks", name)
+	in := convertFromHookInput(input)
+	in.Name = "web"
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks/%s", name, id)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("orgs/%s/hooks/%s", name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function lists the members of an organization or
+// team. The github member list does not include the member
+// role, so the members with the admin role are listed
+// separately and used to populate the role.
+func (s *organizationService) listMembers(ctx context.Context, path, adminRole string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?%s", path, encodeListOptions(opts)), nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	admins := map[string]bool{}
+	page := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		list := []*user{}
+		next, err := s.client.do(ctx, "GET", fmt.Sprintf("%s?role=%s&%s", path, adminRole, encodeListOptions(page)), nil, &list)
+		if err != nil {
+			return nil, next, err
+		}
+		for _, v := range list {
+			admins[v.Login] = true
+		}
+		if next.Page.Next == 0 {
+			break
+		}
+		page.Page = next.Page.Next
+	}
+	return convertMemberList(out, admins), res, nil
+}
+
 func convertOrganizationList(from []*organization) []*scm.Organization {
 	to := []*scm.Organization{}
 	for _, v := range from {
@@ -54,6 +159,17 @@ type membership struct {
 	Role  string `json:"role"`
 }
 
+type membershipInput struct {
+	Role string `json:"role"`
+}
+
+type team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
 func convertOrganization(from *organization) *scm.Organization {
 	return &scm.Organization{
 		Name:   from.Login,
@@ -77,3 +193,35 @@ func convertMembership(from *membership) *scm.Membership {
 	}
 	return to
 }
+
+func convertMemberList(from []*user, admins map[string]bool) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from {
+		member := &scm.Member{
+			User: *convertUser(v),
+			Role: scm.RoleMember,
+		}
+		if admins[v.Login] {
+			member.Role = scm.RoleAdmin
+		}
+		to = append(to, member)
+	}
+	return to
+}
+
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          from.ID,
+		Name:        from.Name,
+		Slug:        from.Slug,
+		Description: from.Description,
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverGithub, ParseURL)
+}
+
+// ParseURL parses a GitHub web or api url into a resource.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case u.Host == "api.github.com" && parts[0] == "repos":
+		return parseAPI(u, parts[1:])
+	case len(parts) > 3 && parts[0] == "api" && parts[1] == "v3" && parts[2] == "repos":
+		return parseAPI(u, parts[3:])
+	case u.Host == "api.github.com":
+		return nil, scm.ErrUnknownURL
+	}
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGithub,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"),
+	}
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pull":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commit":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) >= 2 && rest[0] == "tree":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+	case len(rest) > 2 && (rest[0] == "blob" || rest[0] == "blame"):
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+		res.Lines = scm.ParseLineRange(u.Fragment)
+	case len(rest) == 3 && rest[0] == "releases" && rest[1] == "tag":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[2]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// parseAPI parses the path segments of a GitHub api url
+// that follow the repos/ prefix.
+func parseAPI(u *url.URL, parts []string) (*scm.Resource, error) {
+	if len(parts) < 2 {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGithub,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + parts[1],
+	}
+	var err error
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pulls":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) == 3 && rest[0] == "git" && rest[1] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[2]
+	case len(rest) > 1 && rest[0] == "contents":
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = u.Query().Get("ref")
+		res.Path = strings.Join(rest[1:], "/")
+	case len(rest) == 3 && rest[0] == "releases" && rest[1] == "tags":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[2]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://github.com/octocat/hello-world",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world.git",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/pull/42/files",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/issues/1",
+			want: scm.Resource{Kind: scm.ResourceKindIssue, Number: 1},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/tree/master/docs",
+			want: scm.Resource{Kind: scm.ResourceKindTree, Ref: "master", Path: "docs"},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/blob/master/docs/README.md#L10-L20",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "docs/README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://github.com/octocat/hello-world/releases/tag/v1.0.0",
+			want: scm.Resource{Kind: scm.ResourceKindRelease, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://api.github.com/repos/octocat/hello-world/pulls/42",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://api.github.com/repos/octocat/hello-world/contents/README.md?ref=master",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md"},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverGithub
+		want.Host = got.Host
+		want.Repo = "octocat/hello-world"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Enterprise(t *testing.T) {
+	got, err := ParseURL("https://github.example.com/api/v3/repos/octocat/hello-world/issues/1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	want := &scm.Resource{
+		Driver: scm.DriverGithub,
+		Host:   "github.example.com",
+		Repo:   "octocat/hello-world",
+		Kind:   scm.ResourceKindIssue,
+		Number: 1,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://github.com/octocat",
+		"https://github.com/octocat/hello-world/pull/foo",
+		"https://github.com/octocat/hello-world/-/merge_requests/1",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
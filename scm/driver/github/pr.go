@@ -7,6 +7,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -25,10 +27,16 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	// github requires the head branch to be qualified with
+	// the user or organization name (e.g. octocat:feature).
+	if opts.Source != "" && !strings.Contains(opts.Source, ":") {
+		owner, _ := scm.Split(repo)
+		opts.Source = owner + ":" + opts.Source
+	}
 	path := fmt.Sprintf("repos/%s/pulls?%s", repo, encodePullRequestListOptions(opts))
 	out := []*pr{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
-	return convertPullRequestList(out), res, err
+	return filterPullRequestList(convertPullRequestList(out), opts), res, err
 }
 
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
@@ -38,6 +46,11 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertChangeList(out), res, err
 }
 
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	return s.client.stream(ctx, path, diffMediaType(opts.Format), opts.MaxSize)
+}
+
 func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("/repos/%s/pulls/%d/commits?%s", repo, number, encodeListOptions(opts))
 	out := []*commit{}
@@ -118,12 +131,14 @@ type prInput struct {
 }
 
 type file struct {
-	BlobID    string `json:"sha"`
-	Filename  string `json:"filename"`
-	Status    string `json:"status"`
-	Additions int    `json:"additions"`
-	Deletions int    `json:"deletions"`
-	Changes   int    `json:"changes"`
+	BlobID           string `json:"sha"`
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	Patch            string `json:"patch"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -134,6 +149,46 @@ func convertPullRequestList(from []*pr) []*scm.PullRequest {
 	return to
 }
 
+// helper function filters the pull request list using the
+// list options that are not supported by the github api.
+func filterPullRequestList(from []*scm.PullRequest, opts scm.PullRequestListOptions) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		if opts.Merged && !v.Merged {
+			continue
+		}
+		if opts.Author != "" && v.Author.Login != opts.Author {
+			continue
+		}
+		if !opts.Since.IsZero() && v.Updated.Before(opts.Since) {
+			continue
+		}
+		if !hasLabels(v, opts.Labels) {
+			continue
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
+// helper function returns true if the pull request has
+// all of the named labels.
+func hasLabels(pr *scm.PullRequest, names []string) bool {
+	for _, name := range names {
+		found := false
+		for _, label := range pr.Labels {
+			if label.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func convertPullRequest(from *pr) *scm.PullRequest {
 	var labels []scm.Label
 	for _, label := range from.Labels {
@@ -185,10 +240,14 @@ func convertChangeList(from []*file) []*scm.Change {
 
 func convertChange(from *file) *scm.Change {
 	return &scm.Change{
-		Path:    from.Filename,
-		Added:   from.Status == "added",
-		Deleted: from.Status == "removed",
-		Renamed: from.Status == "renamed",
-		BlobID:  from.BlobID,
+		Path:      from.Filename,
+		PrevPath:  from.PreviousFilename,
+		Added:     from.Status == "added",
+		Deleted:   from.Status == "removed",
+		Renamed:   from.Status == "renamed",
+		BlobID:    from.BlobID,
+		Patch:     from.Patch,
+		Additions: from.Additions,
+		Deletions: from.Deletions,
 	}
 }
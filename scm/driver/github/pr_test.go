@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 
@@ -81,6 +82,70 @@ func TestPullList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestPullListFilter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls").
+		MatchParam("state", "closed").
+		MatchParam("head", "octocat:new-topic").
+		MatchParam("base", "master").
+		MatchParam("sort", "updated").
+		MatchParam("direction", "desc").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pulls.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.List(context.Background(), "octocat/hello-world", scm.PullRequestListOptions{
+		Merged:    true,
+		Source:    "new-topic",
+		Target:    "master",
+		Author:    "octocat",
+		Since:     time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC),
+		Sort:      scm.SortByUpdated,
+		Direction: scm.SortDesc,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/pulls.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullListFilterAuthor(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pulls.json")
+
+	client := NewDefault()
+	got, _, err := client.PullRequests.List(context.Background(), "octocat/hello-world", scm.PullRequestListOptions{
+		Author: "spaceghost",
+		Labels: []string{"bug"},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want pull requests filtered by author, got %d results", len(got))
+	}
+}
+
 func TestPullListChanges(t *testing.T) {
 	gock.New("https://api.github.com").
 		Get("/repos/octocat/hello-world/pulls/1347/files").
@@ -190,6 +255,61 @@ func TestPullCreate(t *testing.T) {
 	t.Run("rate", testRate(res))
 }
 
+func TestPullDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		MatchHeader("Accept", "application/vnd.github.v3.diff").
+		Reply(200).
+		Type("text/plain").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.diff")
+
+	client := NewDefault()
+	rc, res, err := client.PullRequests.Diff(context.Background(), "octocat/hello-world", 1347, scm.DiffOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestPullDiffTooLarge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		MatchHeader("Accept", "application/vnd.github.v3.patch").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/pr.diff")
+
+	client := NewDefault()
+	rc, _, err := client.PullRequests.Diff(context.Background(), "octocat/hello-world", 1347, scm.DiffOptions{
+		Format:  scm.DiffFormatPatch,
+		MaxSize: 16,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	if _, err := ioutil.ReadAll(rc); err != scm.ErrDiffTooLarge {
+		t.Errorf("Want ErrDiffTooLarge, got %v", err)
+	}
+}
+
 func TestPullListCommits(t *testing.T) {
 	gock.New("https://api.github.com").
 		Get("/repos/octocat/hello-world/pulls/1347/commits").
@@ -6,7 +6,9 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -31,7 +33,17 @@ type repository struct {
 	DefaultBranch string    `json:"default_branch"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
-	Permissions   struct {
+	Description   string    `json:"description"`
+	Topics        []string  `json:"topics"`
+	Language      string    `json:"language"`
+	Size          int64     `json:"size"`
+	Archived      bool      `json:"archived"`
+	Stars         int       `json:"stargazers_count"`
+	OpenIssues    int       `json:"open_issues_count"`
+	Parent        *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
+	Permissions struct {
 		Admin bool `json:"admin"`
 		Push  bool `json:"push"`
 		Pull  bool `json:"pull"`
@@ -51,6 +63,24 @@ type hook struct {
 	} `json:"config"`
 }
 
+type hookDelivery struct {
+	ID          int64     `json:"id"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	Duration    float64   `json:"duration"`
+	StatusCode  int       `json:"status_code"`
+	Event       string    `json:"event"`
+	Request     struct {
+		Payload json.RawMessage `json:"payload"`
+	} `json:"request"`
+	Response struct {
+		Payload string `json:"payload"`
+	} `json:"response"`
+}
+
+type topics struct {
+	Names []string `json:"names"`
+}
+
 // RepositoryService implements the repository service for
 // the GitHub driver.
 type RepositoryService struct {
@@ -105,22 +135,39 @@ func (s *RepositoryService) ListStatus(ctx context.Context, repo, ref string, op
 	return convertStatusList(out), res, err
 }
 
+// ListTopics returns the repository topics.
+func (s *RepositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/topics", repo)
+	out := new(topics)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out.Names, res, err
+}
+
+// ListLanguages returns the repository languages.
+func (s *RepositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/languages", repo)
+	out := map[string]int64{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLanguageList(out), res, err
+}
+
+// ReplaceTopics replaces the repository topics.
+func (s *RepositoryService) ReplaceTopics(ctx context.Context, repo string, names []string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/topics", repo)
+	in := &topics{Names: names}
+	if in.Names == nil {
+		in.Names = []string{}
+	}
+	out := new(topics)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return out.Names, res, err
+}
+
 // CreateHook creates a new repository webhook.
 func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks", repo)
-	in := new(hook)
-	in.Active = true
+	in := convertFromHookInput(input)
 	in.Name = "web"
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = input.Target
-	if input.SkipVerify {
-		in.Config.InsecureSSL = "1"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	return convertHook(out), res, err
@@ -158,18 +205,7 @@ func (s *RepositoryService) CreateDeployStatus(ctx context.Context, repo string,
 // UpdateHook updates a repository webhook.
 func (s *RepositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/hooks/%s", repo, id)
-	in := new(hook)
-	in.Active = true
-	in.Config.Secret = input.Secret
-	in.Config.ContentType = "json"
-	in.Config.URL = input.Target
-	if input.SkipVerify {
-		in.Config.InsecureSSL = "1"
-	}
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
 	return convertHook(out), res, err
@@ -181,6 +217,90 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries returns the recent deliveries of a
+// repository webhook. The request and response bodies are
+// only returned by FindHookDelivery.
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries?%s", repo, id, encodeListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := []*hookDelivery{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookDeliveryList(out), res, err
+}
+
+// FindHookDelivery returns a repository webhook delivery.
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s", repo, id, delivery)
+	out := new(hookDelivery)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHookDelivery(out), res, err
+}
+
+// RedeliverHook redelivers a repository webhook delivery.
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s/attempts", repo, id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// PingHook sends a ping event to a repository webhook.
+func (s *RepositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/pings", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// helper function to convert from the github hook delivery
+// list to the common hook delivery structure.
+func convertHookDeliveryList(from []*hookDelivery) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookDelivery(v))
+	}
+	return to
+}
+
+// helper function to convert from the github hook delivery
+// structure to the common hook delivery structure.
+func convertHookDelivery(from *hookDelivery) *scm.HookDelivery {
+	to := &scm.HookDelivery{
+		ID:       strconv.FormatInt(from.ID, 10),
+		Event:    from.Event,
+		Status:   from.StatusCode,
+		Duration: time.Duration(from.Duration * float64(time.Second)),
+		Created:  from.DeliveredAt,
+		Response: from.Response.Payload,
+	}
+	if len(from.Request.Payload) != 0 && string(from.Request.Payload) != "null" {
+		to.Request = string(from.Request.Payload)
+	}
+	return to
+}
+
+// helper function to convert from the github language byte
+// counts to the common language structure, ordered by size.
+func convertLanguageList(from map[string]int64) []*scm.Language {
+	var total int64
+	for _, v := range from {
+		total += v
+	}
+	to := []*scm.Language{}
+	for k, v := range from {
+		lang := &scm.Language{Name: k, Bytes: v}
+		if total > 0 {
+			lang.Percent = float64(v) * 100 / float64(total)
+		}
+		to = append(to, lang)
+	}
+	sort.Slice(to, func(i, j int) bool {
+		if to[i].Bytes == to[j].Bytes {
+			return to[i].Name < to[j].Name
+		}
+		return to[i].Bytes > to[j].Bytes
+	})
+	return to
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -194,7 +314,7 @@ func convertRepositoryList(from []*repository) []*scm.Repository {
 // helper function to convert from the gogs repository structure
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
-	return &scm.Repository{
+	to := &scm.Repository{
 		ID:        strconv.Itoa(from.ID),
 		Name:      from.Name,
 		Namespace: from.Owner.Login,
@@ -211,7 +331,20 @@ func convertRepository(from *repository) *scm.Repository {
 		CloneSSH:   from.SSHURL,
 		Created:    from.CreatedAt,
 		Updated:    from.UpdatedAt,
+
+		Description: from.Description,
+		Topics:      from.Topics,
+		Language:    from.Language,
+		Size:        from.Size,
+		Archived:    from.Archived,
+		Stars:       from.Stars,
+		OpenIssues:  from.OpenIssues,
+		Fork:        from.Fork,
 	}
+	if from.Parent != nil {
+		to.Parent = from.Parent.FullName
+	}
+	return to
 }
 
 func convertHookList(from []*hook) []*scm.Hook {
@@ -232,6 +365,25 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+// helper function to convert from the common hook input
+// structure to the github hook structure. It is shared by
+// the repository and organization hook endpoints.
+func convertFromHookInput(from *scm.HookInput) *hook {
+	to := new(hook)
+	to.Active = true
+	to.Config.Secret = from.Secret
+	to.Config.ContentType = "json"
+	to.Config.URL = from.Target
+	if from.SkipVerify {
+		to.Config.InsecureSSL = "1"
+	}
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -256,6 +408,30 @@ func convertFromHookEvents(from scm.HookEvents) []string {
 	if from.Deployment {
 		events = append(events, "deployment")
 	}
+	if from.PullRequestReview {
+		events = append(events, "pull_request_review")
+	}
+	if from.Release {
+		events = append(events, "release")
+	}
+	if from.Status {
+		events = append(events, "status")
+		events = append(events, "check_run")
+		events = append(events, "check_suite")
+	}
+	if from.Pipeline {
+		events = append(events, "workflow_run")
+		events = append(events, "workflow_job")
+	}
+	if from.Member {
+		events = append(events, "member")
+	}
+	if from.Repository {
+		events = append(events, "repository")
+	}
+	if from.Wiki {
+		events = append(events, "gollum")
+	}
 	return events
 }
 
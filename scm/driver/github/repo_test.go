@@ -240,6 +240,101 @@ func TestDeployStatusCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryTopicList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/topics").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/topics.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListTopics(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryTopicReplace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/topics").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"names": []string{"octocat", "atom", "electron", "api"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/topics.json")
+
+	client := NewDefault()
+	input := []string{"octocat", "atom", "electron", "api"}
+	got, res, err := client.Repositories.ReplaceTopics(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryLanguageList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/languages").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/languages.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListLanguages(context.Background(), "octocat/hello-world")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Language{}
+	raw, _ := ioutil.ReadFile("testdata/languages.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestRepositoryHookFind(t *testing.T) {
 	defer gock.Off()
 
@@ -402,6 +497,116 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_deliveries.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListHookDeliveries(context.Background(), "octocat/hello-world", "1", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/hook_deliveries.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookDeliveryFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries/12345678").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_delivery.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.FindHookDelivery(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.HookDelivery)
+	raw, _ := ioutil.ReadFile("testdata/hook_delivery.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookRedeliver(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/deliveries/12345678/attempts").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.RedeliverHook(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/pings").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.PingHook(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
@@ -505,6 +710,34 @@ func TestHookEvents(t *testing.T) {
 			in:  scm.HookEvents{PullRequest: true},
 			out: []string{"pull_request"},
 		},
+		{
+			in:  scm.HookEvents{PullRequestReview: true},
+			out: []string{"pull_request_review"},
+		},
+		{
+			in:  scm.HookEvents{Release: true},
+			out: []string{"release"},
+		},
+		{
+			in:  scm.HookEvents{Status: true},
+			out: []string{"status", "check_run", "check_suite"},
+		},
+		{
+			in:  scm.HookEvents{Pipeline: true},
+			out: []string{"workflow_run", "workflow_job"},
+		},
+		{
+			in:  scm.HookEvents{Member: true},
+			out: []string{"member"},
+		},
+		{
+			in:  scm.HookEvents{Repository: true},
+			out: []string{"repository"},
+		},
+		{
+			in:  scm.HookEvents{Wiki: true},
+			out: []string{"gollum"},
+		},
 		{
 			in: scm.HookEvents{
 				Branch:             true,
@@ -0,0 +1,173 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// media type used to request text match metadata in the
+// code search results.
+const mediaTypeTextMatch = "application/vnd.github.v3.text-match+json"
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.RepositorySearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	var terms []string
+	if opts.Topic != "" {
+		terms = append(terms, "topic:"+opts.Topic)
+	}
+	if opts.Language != "" {
+		terms = append(terms, "language:"+opts.Language)
+	}
+	if opts.Org != "" {
+		terms = append(terms, "org:"+opts.Org)
+	}
+	path := fmt.Sprintf("search/repositories?%s", encodeSearch(opts.Query, terms, opts.Page, opts.Size))
+	out := new(repositorySearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepositoryList(out.Items), res, err
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.CodeSearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	var terms []string
+	if opts.Repo != "" {
+		terms = append(terms, "repo:"+opts.Repo)
+	}
+	if opts.Org != "" {
+		terms = append(terms, "org:"+opts.Org)
+	}
+	if opts.Path != "" {
+		terms = append(terms, "path:"+opts.Path)
+	}
+	path := fmt.Sprintf("search/code?%s", encodeSearch(opts.Query, terms, opts.Page, opts.Size))
+	out := new(codeSearchResult)
+	res, err := s.client.doMediaType(ctx, "GET", path, mediaTypeTextMatch, nil, out)
+	return convertCodeResultList(out.Items), res, err
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.IssueSearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	var terms []string
+	if opts.PullRequest {
+		terms = append(terms, "is:pr")
+	} else {
+		terms = append(terms, "is:issue")
+	}
+	if opts.Open && !opts.Closed {
+		terms = append(terms, "is:open")
+	} else if opts.Closed && !opts.Open {
+		terms = append(terms, "is:closed")
+	}
+	if opts.Repo != "" {
+		terms = append(terms, "repo:"+opts.Repo)
+	}
+	if opts.Org != "" {
+		terms = append(terms, "org:"+opts.Org)
+	}
+	if opts.Author != "" {
+		terms = append(terms, "author:"+opts.Author)
+	}
+	for _, label := range opts.Labels {
+		terms = append(terms, "label:"+strconv.Quote(label))
+	}
+	switch {
+	case !opts.Since.IsZero() && !opts.Until.IsZero():
+		terms = append(terms, "updated:"+
+			opts.Since.UTC().Format(scm.SearchTimeFormat)+".."+
+			opts.Until.UTC().Format(scm.SearchTimeFormat))
+	case !opts.Since.IsZero():
+		terms = append(terms, "updated:>="+opts.Since.UTC().Format(scm.SearchTimeFormat))
+	case !opts.Until.IsZero():
+		terms = append(terms, "updated:<="+opts.Until.UTC().Format(scm.SearchTimeFormat))
+	}
+	path := fmt.Sprintf("search/issues?%s", encodeSearch(opts.Query, terms, opts.Page, opts.Size))
+	out := new(issueSearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueList(out.Items), res, err
+}
+
+//
+// native data structures
+//
+
+type repositorySearchResult struct {
+	Total int           `json:"total_count"`
+	Items []*repository `json:"items"`
+}
+
+type issueSearchResult struct {
+	Total int      `json:"total_count"`
+	Items []*issue `json:"items"`
+}
+
+type codeSearchResult struct {
+	Total int     `json:"total_count"`
+	Items []*code `json:"items"`
+}
+
+type code struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Sha        string `json:"sha"`
+	HTMLURL    string `json:"html_url"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	TextMatches []struct {
+		Fragment string `json:"fragment"`
+	} `json:"text_matches"`
+}
+
+//
+// native data structure conversion
+//
+
+// helper function encodes the search query and qualifiers
+// along with the pagination parameters.
+func encodeSearch(query string, terms []string, page, size int) string {
+	if query != "" {
+		terms = append([]string{query}, terms...)
+	}
+	params := url.Values{}
+	params.Set("q", strings.Join(terms, " "))
+	if page != 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if size != 0 {
+		params.Set("per_page", strconv.Itoa(size))
+	}
+	return params.Encode()
+}
+
+func convertCodeResultList(from []*code) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+func convertCodeResult(from *code) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Repo: from.Repository.FullName,
+		Path: from.Path,
+		Sha:  from.Sha,
+		Link: from.HTMLURL,
+	}
+	for _, match := range from.TextMatches {
+		to.Fragments = append(to.Fragments, scm.Fragment{
+			Text: match.Fragment,
+		})
+	}
+	return to
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/repositories").
+		MatchParam("q", "^hello topic:go language:go org:octocat$").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_repos.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{
+		Query:    "hello",
+		Topic:    "go",
+		Language: "go",
+		Org:      "octocat",
+		Page:     1,
+		Size:     30,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/search_repos.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/code").
+		MatchHeader("Accept", "application/vnd.github.v3.text-match\\+json").
+		MatchParam("q", "^addClass repo:jquery/jquery path:src/attributes$").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_code.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Code(context.Background(), scm.CodeSearchOptions{
+		Query: "addClass",
+		Repo:  "jquery/jquery",
+		Path:  "src/attributes",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := ioutil.ReadFile("testdata/search_code.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSearchIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/issues").
+		MatchParam("q", `^bug is:issue is:open repo:octocat/hello-world author:octocat label:"help wanted" updated:2017-01-01T00:00:00Z..2017-12-31T00:00:00Z$`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_issues.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{
+		Query:  "bug",
+		Repo:   "octocat/hello-world",
+		Author: "octocat",
+		Labels: []string{"help wanted"},
+		Open:   true,
+		Since:  time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/search_issues.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
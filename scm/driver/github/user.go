@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -35,23 +36,194 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// FindByEmail searches for the user account with a matching
+// public email address and returns the full user account.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("search/users?%s", encodeSearch(email, []string{"in:email"}, 0, 0))
+	out := new(userSearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Items) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return s.FindLogin(ctx, out.Items[0].Login)
+}
+
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	path := fmt.Sprintf("user/emails?%s", encodeListOptions(opts))
+	out := []*email{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertEmailList(out), res, err
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{
+		Key: input.Key,
+	}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("users/%s/repos?%s", login, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
-	ID      int         `json:"id"`
-	Login   string      `json:"login"`
-	Name    string      `json:"name"`
-	Email   null.String `json:"email"`
-	Avatar  string      `json:"avatar_url"`
-	Created time.Time   `json:"created_at"`
-	Updated time.Time   `json:"updated_at"`
+	ID        int         `json:"id"`
+	Login     string      `json:"login"`
+	Name      string      `json:"name"`
+	Email     null.String `json:"email"`
+	Avatar    string      `json:"avatar_url"`
+	HTMLURL   string      `json:"html_url"`
+	Type      string      `json:"type"`
+	SiteAdmin bool        `json:"site_admin"`
+	Created   time.Time   `json:"created_at"`
+	Updated   time.Time   `json:"updated_at"`
+}
+
+type userSearchResult struct {
+	Total int     `json:"total_count"`
+	Items []*user `json:"items"`
+}
+
+type email struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+type gpgKey struct {
+	ID      int       `json:"id"`
+	KeyID   string    `json:"key_id"`
+	Key     string    `json:"raw_key"`
+	Created time.Time `json:"created_at"`
+	Expires null.Time `json:"expires_at"`
+	Emails  []struct {
+		Email string `json:"email"`
+	} `json:"emails"`
+}
+
+type gpgKeyInput struct {
+	Key string `json:"armored_public_key"`
 }
 
 func convertUser(from *user) *scm.User {
 	return &scm.User{
+		ID:      strconv.Itoa(from.ID),
 		Avatar:  from.Avatar,
 		Email:   from.Email.String,
 		Login:   from.Login,
 		Name:    from.Name,
+		Link:    from.HTMLURL,
+		Type:    convertUserType(from.Type),
+		Admin:   from.SiteAdmin,
 		Created: from.Created,
 		Updated: from.Updated,
 	}
 }
+
+func convertUserType(from string) scm.UserType {
+	switch from {
+	case "User":
+		return scm.UserTypeUser
+	case "Bot":
+		return scm.UserTypeBot
+	case "Organization":
+		return scm.UserTypeOrg
+	default:
+		return scm.UserTypeUndefined
+	}
+}
+
+func convertEmailList(from []*email) []*scm.Email {
+	to := []*scm.Email{}
+	for _, v := range from {
+		to = append(to, &scm.Email{
+			Address:  v.Email,
+			Primary:  v.Primary,
+			Verified: v.Verified,
+		})
+	}
+	return to
+}
+
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      from.ID,
+		Title:   from.Title,
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
+
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	to := &scm.GPGKey{
+		ID:      from.ID,
+		KeyID:   from.KeyID,
+		Key:     from.Key,
+		Created: from.Created,
+		Expires: from.Expires.Time,
+	}
+	for _, v := range from.Emails {
+		to.Emails = append(to.Emails, v.Email)
+	}
+	return to
+}
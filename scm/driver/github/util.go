@@ -11,6 +11,15 @@ import (
 	"github.com/drone/go-scm/scm"
 )
 
+// diffMediaType returns the media type used to request a
+// raw diff or patch.
+func diffMediaType(format scm.DiffFormat) string {
+	if format == scm.DiffFormatPatch {
+		return "application/vnd.github.v3.patch"
+	}
+	return "application/vnd.github.v3.diff"
+}
+
 func encodeListOptions(opts scm.ListOptions) string {
 	params := url.Values{}
 	if opts.Page != 0 {
@@ -33,6 +42,18 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Ref != "" {
 		params.Set("ref", opts.Ref)
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(scm.SearchTimeFormat))
+	}
 	return params.Encode()
 }
 
@@ -62,9 +83,21 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
-	} else if opts.Closed {
+	} else if opts.Closed || opts.Merged {
 		params.Set("state", "closed")
 	}
+	if opts.Source != "" {
+		params.Set("head", opts.Source)
+	}
+	if opts.Target != "" {
+		params.Set("base", opts.Target)
+	}
+	if opts.Sort != scm.SortByDefault {
+		params.Set("sort", opts.Sort.String())
+	}
+	if opts.Direction != scm.SortDirectionDefault {
+		params.Set("direction", opts.Direction.String())
+	}
 	return params.Encode()
 }
 
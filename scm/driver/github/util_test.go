@@ -6,6 +6,7 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -35,6 +36,21 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Filter(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Ref:    "master",
+		Path:   "docs",
+		Author: "octocat",
+		Since:  time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	want := "author=octocat&path=docs&ref=master&since=2017-01-01T00%3A00%3A00Z&until=2017-12-31T00%3A00%3A00Z"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -36,6 +36,13 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// push hooks delivered by an organization hook
+		{
+			event:  "push",
+			before: "testdata/webhooks/push_org.json",
+			after:  "testdata/webhooks/push_org.json.golden",
+			obj:    new(scm.PushHook),
+		},
 		// push tag create hooks
 		{
 			event:  "push",
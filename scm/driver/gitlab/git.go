@@ -7,6 +7,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -81,6 +82,37 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(out.Diffs), res, err
 }
 
+// Diff returns the diff of the commit, built from the file
+// diffs. Gitlab does not support the patch format.
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	if opts.Format != scm.DiffFormatDiff {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/diff", encode(repo), ref)
+	out := []*change{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertDiff(out, opts.MaxSize), res, nil
+}
+
+// CompareDiff returns the diff between two commits, built
+// from the file diffs. Gitlab does not support the patch
+// format.
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	if opts.Format != scm.DiffFormatDiff {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/compare?from=%s&to=%s", encode(repo), source, target)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertDiff(out.Diffs, opts.MaxSize), res, nil
+}
+
 type branch struct {
 	Name   string `json:"name"`
 	Commit struct {
@@ -23,12 +23,12 @@ func (l *linker) Resource(ctx context.Context, repo string, ref scm.Reference) (
 		return fmt.Sprintf("%s%s/-/tags/%s", l.base, repo, t), nil
 	case scm.IsPullRequest(ref.Path):
 		d := scm.ExtractPullRequest(ref.Path)
-		return fmt.Sprintf("%s%s/merge_requests/%d", l.base, repo, d), nil
+		return fmt.Sprintf("%s%s/-/merge_requests/%d", l.base, repo, d), nil
 	case ref.Sha == "":
 		t := scm.TrimRef(ref.Path)
-		return fmt.Sprintf("%s%s/tree/%s", l.base, repo, t), nil
+		return fmt.Sprintf("%s%s/-/tree/%s", l.base, repo, t), nil
 	default:
-		return fmt.Sprintf("%s%s/commit/%s", l.base, repo, ref.Sha), nil
+		return fmt.Sprintf("%s%s/-/commit/%s", l.base, repo, ref.Sha), nil
 	}
 }
 
@@ -36,7 +36,7 @@ func (l *linker) Resource(ctx context.Context, repo string, ref scm.Reference) (
 func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Reference) (string, error) {
 	if scm.IsPullRequest(target.Path) {
 		d := scm.ExtractPullRequest(target.Path)
-		return fmt.Sprintf("%s%s/merge_requests/%d/diffs", l.base, repo, d), nil
+		return fmt.Sprintf("%s%s/-/merge_requests/%d/diffs", l.base, repo, d), nil
 	}
 
 	s := source.Sha
@@ -48,5 +48,57 @@ func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Refer
 		t = scm.TrimRef(target.Path)
 	}
 
-	return fmt.Sprintf("%s%s/compare/%s...%s", l.base, repo, s, t), nil
+	return fmt.Sprintf("%s%s/-/compare/%s...%s", l.base, repo, s, t), nil
+}
+
+// File returns a link to the file.
+func (l *linker) File(ctx context.Context, repo string, ref scm.Reference, path string, lines scm.LineRange) (string, error) {
+	return fmt.Sprintf("%s%s/-/blob/%s/%s%s", l.base, repo, refName(ref), path, lineAnchor(lines)), nil
+}
+
+// Blame returns a link to the blame view of the file.
+func (l *linker) Blame(ctx context.Context, repo string, ref scm.Reference, path string) (string, error) {
+	return fmt.Sprintf("%s%s/-/blame/%s/%s", l.base, repo, refName(ref), path), nil
+}
+
+// Issue returns a link to the issue.
+func (l *linker) Issue(ctx context.Context, repo string, number int) (string, error) {
+	return fmt.Sprintf("%s%s/-/issues/%d", l.base, repo, number), nil
+}
+
+// PullRequestComment returns a link to the merge request note.
+func (l *linker) PullRequestComment(ctx context.Context, repo string, number, id int) (string, error) {
+	return fmt.Sprintf("%s%s/-/merge_requests/%d#note_%d", l.base, repo, number, id), nil
+}
+
+// Release returns a link to the release.
+func (l *linker) Release(ctx context.Context, repo, tag string) (string, error) {
+	return fmt.Sprintf("%s%s/-/releases/%s", l.base, repo, tag), nil
+}
+
+// User returns a link to the user profile.
+func (l *linker) User(ctx context.Context, login string) (string, error) {
+	return fmt.Sprintf("%s%s", l.base, login), nil
+}
+
+// refName returns the commit sha of the reference, falling
+// back to the short reference name.
+func refName(ref scm.Reference) string {
+	if ref.Sha != "" {
+		return ref.Sha
+	}
+	return scm.TrimRef(ref.Path)
+}
+
+// lineAnchor returns the url fragment that highlights the
+// range of lines.
+func lineAnchor(lines scm.LineRange) string {
+	switch {
+	case lines.Start == 0:
+		return ""
+	case lines.End == 0 || lines.End == lines.Start:
+		return fmt.Sprintf("#L%d", lines.Start)
+	default:
+		return fmt.Sprintf("#L%d-%d", lines.Start, lines.End)
+	}
 }
@@ -20,12 +20,12 @@ func TestLink(t *testing.T) {
 		{
 			path: "refs/heads/master",
 			sha:  "a7389057b0eb027e73b32a81e3c5923a71d01dde",
-			want: "https://gitlab.com/octocat/hello-world/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
+			want: "https://gitlab.com/octocat/hello-world/-/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
 		},
 		{
 			path: "refs/pull/42/head",
 			sha:  "a7389057b0eb027e73b32a81e3c5923a71d01dde",
-			want: "https://gitlab.com/octocat/hello-world/merge_requests/42",
+			want: "https://gitlab.com/octocat/hello-world/-/merge_requests/42",
 		},
 		{
 			path: "refs/tags/v1.0.0",
@@ -33,7 +33,7 @@ func TestLink(t *testing.T) {
 		},
 		{
 			path: "refs/heads/master",
-			want: "https://gitlab.com/octocat/hello-world/tree/master",
+			want: "https://gitlab.com/octocat/hello-world/-/tree/master",
 		},
 	}
 
@@ -64,21 +64,21 @@ func TestDiff(t *testing.T) {
 		{
 			source: scm.Reference{Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"},
 			target: scm.Reference{Sha: "49bbaf4a113bbebfa21cf604cad9aa1503c3f04d"},
-			want:   "https://gitlab.com/octocat/hello-world/compare/a7389057b0eb027e73b32a81e3c5923a71d01dde...49bbaf4a113bbebfa21cf604cad9aa1503c3f04d",
+			want:   "https://gitlab.com/octocat/hello-world/-/compare/a7389057b0eb027e73b32a81e3c5923a71d01dde...49bbaf4a113bbebfa21cf604cad9aa1503c3f04d",
 		},
 		{
 			source: scm.Reference{Path: "refs/heads/master"},
 			target: scm.Reference{Sha: "49bbaf4a113bbebfa21cf604cad9aa1503c3f04d"},
-			want:   "https://gitlab.com/octocat/hello-world/compare/master...49bbaf4a113bbebfa21cf604cad9aa1503c3f04d",
+			want:   "https://gitlab.com/octocat/hello-world/-/compare/master...49bbaf4a113bbebfa21cf604cad9aa1503c3f04d",
 		},
 		{
 			source: scm.Reference{Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"},
 			target: scm.Reference{Path: "refs/heads/master"},
-			want:   "https://gitlab.com/octocat/hello-world/compare/a7389057b0eb027e73b32a81e3c5923a71d01dde...master",
+			want:   "https://gitlab.com/octocat/hello-world/-/compare/a7389057b0eb027e73b32a81e3c5923a71d01dde...master",
 		},
 		{
 			target: scm.Reference{Path: "refs/pull/12/head"},
-			want:   "https://gitlab.com/octocat/hello-world/merge_requests/12/diffs",
+			want:   "https://gitlab.com/octocat/hello-world/-/merge_requests/12/diffs",
 		},
 	}
 
@@ -95,3 +95,80 @@ func TestDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		link func(context.Context, scm.Linker) (string, error)
+		want string
+	}{
+		{
+			name: "File",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.File(ctx, "gitlab-org/sub/hello-world", scm.Reference{Path: "refs/heads/master", Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"}, "README.md", scm.LineRange{Start: 10, End: 20})
+			},
+			want: "https://gitlab.com/gitlab-org/sub/hello-world/-/blob/a7389057b0eb027e73b32a81e3c5923a71d01dde/README.md#L10-20",
+		},
+		{
+			name: "Blame",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Blame(ctx, "gitlab-org/sub/hello-world", scm.Reference{Path: "refs/heads/master"}, "README.md")
+			},
+			want: "https://gitlab.com/gitlab-org/sub/hello-world/-/blame/master/README.md",
+		},
+		{
+			name: "Issue",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Issue(ctx, "gitlab-org/sub/hello-world", 42)
+			},
+			want: "https://gitlab.com/gitlab-org/sub/hello-world/-/issues/42",
+		},
+		{
+			name: "PullRequestComment",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.PullRequestComment(ctx, "gitlab-org/sub/hello-world", 42, 1)
+			},
+			want: "https://gitlab.com/gitlab-org/sub/hello-world/-/merge_requests/42#note_1",
+		},
+		{
+			name: "Release",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.Release(ctx, "gitlab-org/sub/hello-world", "v1.0.0")
+			},
+			want: "https://gitlab.com/gitlab-org/sub/hello-world/-/releases/v1.0.0",
+		},
+		{
+			name: "User",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.User(ctx, "octocat")
+			},
+			want: "https://gitlab.com/octocat",
+		},
+	}
+
+	client := NewDefault()
+	for _, test := range tests {
+		got, err := test.link(context.Background(), client.Linker)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if want := test.want; got != want {
+			t.Errorf("%s: want link %q, got %q", test.name, want, got)
+		}
+	}
+}
+
+func TestLinkSubgroup(t *testing.T) {
+	client := NewDefault()
+	ref := scm.Reference{Path: "refs/merge-requests/42/head"}
+	got, err := client.Linker.Resource(context.Background(), "gitlab-org/gitter/gitter-demo-app", ref)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	want := "https://gitlab.com/gitlab-org/gitter/gitter-demo-app/-/merge_requests/42"
+	if got != want {
+		t.Errorf("Want link %q, got %q", want, got)
+	}
+}
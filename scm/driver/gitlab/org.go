@@ -7,6 +7,8 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -24,7 +26,21 @@ func (s *organizationService) Find(ctx context.Context, name string) (*scm.Organ
 }
 
 func (s *organizationService) FindMembership(ctx context.Context, name, username string) (*scm.Membership, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the members endpoint is keyed by numeric user id, so we
+	// search the group members (including inherited members)
+	// by username and select the exact match.
+	path := fmt.Sprintf("api/v4/groups/%s/members/all?query=%s", encode(name), url.QueryEscape(username))
+	out := []*member{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, m := range out {
+		if m.Username == username {
+			return convertMembership(m), res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
 }
 
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
@@ -34,10 +50,124 @@ func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([
 	return convertOrganizationList(out), res, err
 }
 
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/members/all?%s", encode(name), encodeListOptions(opts))
+	out := []*member{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMemberList(out), res, err
+}
+
+// ListTeams returns the subgroups of the group. GitLab has
+// no teams, subgroups are used to organize group members.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/subgroups?%s", encode(name), encodeListOptions(opts))
+	out := []*organization{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+// ListTeamMembers returns the members of the subgroup, where
+// the team is the subgroup path relative to the group.
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	return s.ListMembers(ctx, name+"/"+team, opts)
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/projects?%s", encode(name), encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	id, res, err := s.findUserID(ctx, username)
+	if err != nil {
+		return nil, res, err
+	}
+	params := url.Values{}
+	params.Set("user_id", strconv.Itoa(id))
+	params.Set("access_level", strconv.Itoa(convertFromRole(role)))
+	path := fmt.Sprintf("api/v4/groups/%s/members?%s", encode(name), params.Encode())
+	out := new(member)
+	res, err = s.client.do(ctx, "POST", path, nil, out)
+	return convertMembership(out), res, err
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	id, res, err := s.findUserID(ctx, username)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v4/groups/%s/members/%d", encode(name), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s", encode(name), id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(name), encodeListOptions(opts))
+	out := []*hook{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookList(out), res, err
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookCreateParams(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks?%s", encode(name), params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookUpdateParams(input)
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s?%s", encode(name), id, params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/groups/%s/hooks/%s", encode(name), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// helper function returns the numeric id of the user account,
+// which is required to add or remove group members.
+func (s *organizationService) findUserID(ctx context.Context, username string) (int, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/users?username=%s", url.QueryEscape(username))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return 0, res, err
+	}
+	if len(out) == 0 {
+		return 0, res, scm.ErrNotFound
+	}
+	return out[0].ID, res, nil
+}
+
 type organization struct {
-	Name   string      `json:"name"`
-	Path   string      `json:"path"`
-	Avatar null.String `json:"avatar_url"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Path        string      `json:"path"`
+	Description string      `json:"description"`
+	Avatar      null.String `json:"avatar_url"`
+}
+
+type member struct {
+	ID          int    `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Avatar      string `json:"avatar_url"`
+	WebURL      string `json:"web_url"`
+	AccessLevel int    `json:"access_level"`
 }
 
 func convertOrganizationList(from []*organization) []*scm.Organization {
@@ -54,3 +184,69 @@ func convertOrganization(from *organization) *scm.Organization {
 		Avatar: from.Avatar.String,
 	}
 }
+
+func convertTeamList(from []*organization) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *organization) *scm.Team {
+	return &scm.Team{
+		ID:          from.ID,
+		Name:        from.Name,
+		Slug:        from.Path,
+		Description: from.Description,
+	}
+}
+
+func convertMemberList(from []*member) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from {
+		to = append(to, &scm.Member{
+			User: scm.User{
+				ID:     strconv.Itoa(v.ID),
+				Login:  v.Username,
+				Name:   v.Name,
+				Avatar: v.Avatar,
+				Link:   v.WebURL,
+				Type:   scm.UserTypeUser,
+			},
+			Role: convertRole(v.AccessLevel),
+		})
+	}
+	return to
+}
+
+func convertMembership(from *member) *scm.Membership {
+	return &scm.Membership{
+		Active: from.State == "active",
+		Role:   convertRole(from.AccessLevel),
+	}
+}
+
+// helper function converts the gitlab access level to the
+// common role. Only group owners can administer the group.
+func convertRole(from int) scm.Role {
+	switch {
+	case from >= 50:
+		return scm.RoleAdmin
+	case from > 0:
+		return scm.RoleMember
+	default:
+		return scm.RoleUndefined
+	}
+}
+
+// helper function converts the common role to the gitlab
+// access level, where members are granted developer access.
+func convertFromRole(from scm.Role) int {
+	switch from {
+	case scm.RoleAdmin:
+		return 50
+	default:
+		return 30
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverGitlab, ParseURL)
+}
+
+// keywords that separate the project path from the
+// resource path in legacy urls without the /-/ separator.
+var keywords = map[string]bool{
+	"merge_requests": true,
+	"issues":         true,
+	"commit":         true,
+	"tree":           true,
+	"blob":           true,
+	"blame":          true,
+	"tags":           true,
+	"releases":       true,
+}
+
+// reserved top-level path segments of api urls, which
+// cannot be the name of a GitLab group or user.
+var reserved = map[string]bool{
+	"api":  true,
+	"rest": true,
+}
+
+// ParseURL parses a GitLab web or api url into a resource.
+// The project path of web urls may include nested groups.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	parts := splitPath(u.EscapedPath())
+	if len(parts) > 3 && parts[0] == "api" && parts[1] == "v4" && parts[2] == "projects" {
+		return parseAPI(u, parts[3:])
+	}
+	// reject other api urls, which would otherwise be
+	// mistaken for a nested group path.
+	if reserved[parts[0]] {
+		return nil, scm.ErrUnknownURL
+	}
+
+	var repo, rest []string
+	for i, part := range parts {
+		if part == "-" {
+			repo, rest = parts[:i], parts[i+1:]
+			break
+		}
+		if i >= 2 && keywords[part] {
+			repo, rest = parts[:i], parts[i:]
+			break
+		}
+	}
+	if repo == nil {
+		repo = parts
+	}
+	if len(repo) < 2 {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGitlab,
+		Host:   u.Host,
+		Repo:   strings.TrimSuffix(strings.Join(repo, "/"), ".git"),
+	}
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "merge_requests":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commit":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) >= 2 && rest[0] == "tree":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+	case len(rest) > 2 && (rest[0] == "blob" || rest[0] == "blame"):
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+		res.Lines = scm.ParseLineRange(u.Fragment)
+	case len(rest) == 2 && rest[0] == "tags":
+		res.Kind = scm.ResourceKindTag
+		res.Ref = rest[1]
+	case len(rest) == 2 && rest[0] == "releases":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[1]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// parseAPI parses the path segments of a GitLab api url
+// that follow the projects/ prefix. The project is either
+// the url encoded project path or the numeric project id.
+func parseAPI(u *url.URL, parts []string) (*scm.Resource, error) {
+	res := &scm.Resource{
+		Driver: scm.DriverGitlab,
+		Host:   u.Host,
+		Repo:   parts[0],
+	}
+	var err error
+	rest := parts[1:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "merge_requests":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 3 && rest[0] == "repository" && rest[1] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[2]
+	case len(rest) >= 3 && rest[0] == "repository" && rest[1] == "files":
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = u.Query().Get("ref")
+		res.Path = rest[2]
+	case len(rest) == 2 && rest[0] == "repository" && rest[1] == "tree":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = u.Query().Get("ref")
+		res.Path = u.Query().Get("path")
+	case len(rest) == 3 && rest[0] == "repository" && rest[1] == "tags":
+		res.Kind = scm.ResourceKindTag
+		res.Ref = rest[2]
+	case len(rest) == 2 && rest[0] == "releases":
+		res.Kind = scm.ResourceKindRelease
+		res.Ref = rest[1]
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// splitPath splits the escaped url path into unescaped
+// segments, preserving encoded slashes within a segment.
+func splitPath(path string) []string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i, part := range parts {
+		if s, err := url.PathUnescape(part); err == nil {
+			parts[i] = s
+		}
+	}
+	return parts
+}
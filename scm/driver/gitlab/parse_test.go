@@ -0,0 +1,96 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://gitlab.com/diaspora/diaspora",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://gitlab.example.com/group/sub/proj/-/merge_requests/12",
+			want: scm.Resource{Repo: "group/sub/proj", Kind: scm.ResourceKindPullRequest, Number: 12},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/merge_requests/12/diffs",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindPullRequest, Number: 12},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/-/issues/1",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindIssue, Number: 1},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/-/commit/6104942438c14ec7bd21c6cd5bd995272b3faff6",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindCommit, Sha: "6104942438c14ec7bd21c6cd5bd995272b3faff6"},
+		},
+		{
+			url:  "https://gitlab.com/group/sub/proj/-/tree/master/docs",
+			want: scm.Resource{Repo: "group/sub/proj", Kind: scm.ResourceKindTree, Ref: "master", Path: "docs"},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/-/blob/master/README.md#L10-20",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/-/tags/v1.0.0",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindTag, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://gitlab.com/diaspora/diaspora/-/releases/v1.0.0",
+			want: scm.Resource{Repo: "diaspora/diaspora", Kind: scm.ResourceKindRelease, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://gitlab.com/api/v4/projects/group%2Fsub%2Fproj/merge_requests/12",
+			want: scm.Resource{Repo: "group/sub/proj", Kind: scm.ResourceKindPullRequest, Number: 12},
+		},
+		{
+			url:  "https://gitlab.com/api/v4/projects/178504/repository/files/docs%2FREADME.md?ref=master",
+			want: scm.Resource{Repo: "178504", Kind: scm.ResourceKindBlob, Ref: "master", Path: "docs/README.md"},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverGitlab
+		want.Host = got.Host
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://gitlab.com/diaspora",
+		"https://gitlab.com/diaspora/diaspora/-/merge_requests/foo",
+		"https://gitlab.com/diaspora/diaspora/-/pipelines/1",
+		"https://gitlab.com/api/v4/projects",
+		"http://x/api/v1/repos/a",
+		"http://x/api/v1/repos/a/b",
+		"http://x/rest/api/1.0/projects/A/repos",
+		"http://x/rest/api/1.0/projects/A/repos/b",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
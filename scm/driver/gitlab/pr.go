@@ -7,7 +7,10 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -45,6 +48,23 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertChangeList(out.Changes), res, err
 }
 
+// Diff returns the diff of the merge request. Gitlab does
+// not provide an endpoint for the raw diff, which is built
+// from the merge request changes instead. Gitlab does not
+// support the patch format.
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	if opts.Format != scm.DiffFormatDiff {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes", encode(repo), number)
+	out := new(changes)
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertDiff(out.Changes, opts.MaxSize), res, nil
+}
+
 func (s *pullService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes?%s", encode(repo), index, encodeListOptions(opts))
 	out := []*issueComment{}
@@ -126,9 +146,12 @@ type changes struct {
 type change struct {
 	OldPath string `json:"old_path"`
 	NewPath string `json:"new_path"`
+	OldMode string `json:"a_mode"`
+	NewMode string `json:"b_mode"`
 	Added   bool   `json:"new_file"`
 	Renamed bool   `json:"renamed_file"`
 	Deleted bool   `json:"deleted_file"`
+	Diff    string `json:"diff"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -176,15 +199,56 @@ func convertChangeList(from []*change) []*scm.Change {
 	return to
 }
 
+// convertDiff concatenates the file diffs, which omit the
+// file headers, into a single unified diff.
+func convertDiff(from []*change, limit int64) io.ReadCloser {
+	buf := new(strings.Builder)
+	for _, v := range from {
+		oldPath, newPath := "a/"+v.OldPath, "b/"+v.NewPath
+		fmt.Fprintf(buf, "diff --git %s %s\n", oldPath, newPath)
+		switch {
+		case v.Added:
+			fmt.Fprintf(buf, "new file mode %s\n", v.NewMode)
+			oldPath = "/dev/null"
+		case v.Deleted:
+			fmt.Fprintf(buf, "deleted file mode %s\n", v.OldMode)
+			newPath = "/dev/null"
+		case v.Renamed:
+			fmt.Fprintf(buf, "rename from %s\nrename to %s\n", v.OldPath, v.NewPath)
+		}
+		// older versions of gitlab include the file
+		// headers in the diff.
+		if v.Diff != "" && !scm.IsBinaryPatch(v.Diff) && !strings.HasPrefix(v.Diff, "--- ") {
+			fmt.Fprintf(buf, "--- %s\n+++ %s\n", oldPath, newPath)
+		}
+		buf.WriteString(v.Diff)
+		if v.Diff != "" && !strings.HasSuffix(v.Diff, "\n") {
+			buf.WriteString("\n")
+		}
+	}
+	return scm.LimitReadCloser(ioutil.NopCloser(strings.NewReader(buf.String())), limit)
+}
+
 func convertChange(from *change) *scm.Change {
 	to := &scm.Change{
 		Path:    from.NewPath,
 		Added:   from.Added,
 		Deleted: from.Deleted,
 		Renamed: from.Renamed,
+		Patch:   from.Diff,
+		Binary:  scm.IsBinaryPatch(from.Diff),
 	}
 	if to.Path == "" {
 		to.Path = from.OldPath
 	}
+	if from.Renamed {
+		to.PrevPath = from.OldPath
+	}
+	if !from.Deleted {
+		to.Mode = from.NewMode
+	}
+	// gitlab does not provide line statistics, which are
+	// calculated from the diff instead.
+	to.Additions, to.Deletions = scm.PatchStats(from.Diff)
 	return to
 }
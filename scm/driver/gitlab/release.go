@@ -26,6 +26,9 @@ type releaseInput struct {
 	Tag         string `json:"tag_name"`
 }
 
+// Find is not supported. The GitLab release api identifies
+// releases by tag name and does not return a release id, so
+// there is no id to match; use FindByTag.
 func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -56,6 +59,9 @@ func (s *releaseService) Create(ctx context.Context, repo string, input *scm.Rel
 	return convertRelease(out), res, err
 }
 
+// Delete is not supported. The GitLab release api identifies
+// releases by tag name and does not return a release id, so
+// there is no id to match; use DeleteByTag.
 func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -65,9 +71,11 @@ func (s *releaseService) DeleteByTag(ctx context.Context, repo string, tag strin
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Update is not supported. The GitLab release api identifies
+// releases by tag name and does not return a release id, so
+// there is no id to match; use UpdateByTag.
 func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	// this could be implemented by List and filter but would be to expensive
-	panic("gitlab only allows to update a release by tag")
+	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
@@ -97,7 +105,7 @@ func convertReleaseList(from []*release) []*scm.Release {
 
 func convertRelease(from *release) *scm.Release {
 	return &scm.Release{
-		ID:          0,
+		ID:          0, // not returned by gitlab
 		Title:       from.Title,
 		Description: from.Description,
 		Link:        "",
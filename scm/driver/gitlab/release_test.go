@@ -12,6 +12,30 @@ import (
 	"github.com/h2non/gock"
 )
 
+func TestReleaseFind(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.Find(context.Background(), "diaspora/diaspora", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Releases.Update(context.Background(), "diaspora/diaspora", 1, &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDelete(t *testing.T) {
+	client := NewDefault()
+	_, err := client.Releases.Delete(context.Background(), "diaspora/diaspora", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestReleaseFindByTag(t *testing.T) {
 	defer gock.Off()
 
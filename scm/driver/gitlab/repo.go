@@ -6,10 +6,11 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -27,6 +28,18 @@ type repository struct {
 	HTTPURL       string      `json:"http_url_to_repo"`
 	Namespace     namespace   `json:"namespace"`
 	Permissions   permissions `json:"permissions"`
+	Description   null.String `json:"description"`
+	Topics        []string    `json:"topics"`
+	TagList       []string    `json:"tag_list"`
+	Archived      bool        `json:"archived"`
+	StarCount     int         `json:"star_count"`
+	OpenIssues    int         `json:"open_issues_count"`
+	ForkedFrom    *struct {
+		PathNamespace string `json:"path_with_namespace"`
+	} `json:"forked_from_project"`
+	Statistics struct {
+		RepositorySize int64 `json:"repository_size"`
+	} `json:"statistics"`
 }
 
 type namespace struct {
@@ -57,16 +70,31 @@ type hook struct {
 	JobEvents             bool      `json:"job_events"`
 	PipelineEvents        bool      `json:"pipeline_events"`
 	WikiPageEvents        bool      `json:"wiki_page_events"`
+	ReleasesEvents        bool      `json:"releases_events"`
+	MemberEvents          bool      `json:"member_events"`
 	EnableSslVerification bool      `json:"enable_ssl_verification"`
 	CreatedAt             time.Time `json:"created_at"`
 }
 
+type hookEvent struct {
+	ID                int             `json:"id"`
+	Trigger           string          `json:"trigger"`
+	RequestData       json.RawMessage `json:"request_data"`
+	ResponseBody      string          `json:"response_body"`
+	ResponseStatus    string          `json:"response_status"`
+	ExecutionDuration float64         `json:"execution_duration"`
+}
+
+type topicsInput struct {
+	Topics []string `json:"topics"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
 
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	path := fmt.Sprintf("api/v4/projects/%s?statistics=true", encode(repo))
 	out := new(repository)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	return convertRepository(out), res, err
@@ -87,7 +115,7 @@ func (s *repositoryService) FindPerms(ctx context.Context, repo string) (*scm.Pe
 }
 
 func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects?%s", encodeMemberListOptions(opts))
+	path := fmt.Sprintf("api/v4/projects?statistics=true&%s", encodeMemberListOptions(opts))
 	out := []*repository{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertRepositoryList(out), res, err
@@ -107,7 +135,92 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 	return convertStatusList(out), res, err
 }
 
+func (s *repositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	out := new(repository)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepository(out).Topics, res, err
+}
+
+func (s *repositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/languages", encode(repo))
+	out := map[string]float64{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLanguageList(out), res, err
+}
+
+func (s *repositoryService) ReplaceTopics(ctx context.Context, repo string, topics []string) ([]string, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	in := &topicsInput{Topics: topics}
+	if in.Topics == nil {
+		in.Topics = []string{}
+	}
+	out := new(repository)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertRepository(out).Topics, res, err
+}
+
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookCreateParams(input)
+	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("state", convertFromState(input.State))
+	params.Set("name", input.Label)
+	params.Set("target_url", input.Target)
+	path := fmt.Sprintf("api/v4/projects/%s/statuses/%s?%s", encode(repo), ref, params.Encode())
+	out := new(status)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertStatus(out), res, err
+}
+
+func (s *repositoryService) UpdateHook(ctx context.Context, repo string, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	params := encodeHookUpdateParams(input)
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s?%s", encode(repo), id, params.Encode())
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertHook(out), res, err
+}
+
+func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s", encode(repo), id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events?%s", encode(repo), id, encodeListOptions(opts))
+	out := []*hookEvent{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookEventList(out), res, err
+}
+
+// FindHookDelivery is not supported. GitLab does not
+// provide an endpoint to get a single hook event, however,
+// the hook event list includes the request and response.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events/%s/resend", encode(repo), id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// PingHook triggers a test push event, since GitLab does
+// not have a dedicated ping event.
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/test/push_events", encode(repo), id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// helper function encodes the hook input as query
+// parameters for creating a project or group hook.
+func encodeHookCreateParams(input *scm.HookInput) url.Values {
 	params := url.Values{}
 	params.Set("url", input.Target)
 	if input.Secret != "" {
@@ -135,31 +248,96 @@ func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *
 	if input.Events.Tag {
 		params.Set("tag_push_events", "true")
 	}
-
-	path := fmt.Sprintf("api/v4/projects/%s/hooks?%s", encode(repo), params.Encode())
-	out := new(hook)
-	res, err := s.client.do(ctx, "POST", path, nil, out)
-	return convertHook(out), res, err
+	if input.Events.Pipeline {
+		params.Set("pipeline_events", "true")
+		params.Set("job_events", "true")
+	}
+	if input.Events.Release {
+		params.Set("releases_events", "true")
+	}
+	if input.Events.Wiki {
+		params.Set("wiki_page_events", "true")
+	}
+	if input.Events.Member {
+		// member events are only available to group hooks.
+		params.Set("member_events", "true")
+	}
+	return params
 }
 
-func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
+// helper function encodes the hook input as query
+// parameters for updating a project or group hook.
+func encodeHookUpdateParams(input *scm.HookInput) url.Values {
+	// unlike create, every event flag is sent explicitly so
+	// that events omitted from the input are disabled.
 	params := url.Values{}
-	params.Set("state", convertFromState(input.State))
-	params.Set("name", input.Label)
-	params.Set("target_url", input.Target)
-	path := fmt.Sprintf("api/v4/projects/%s/statuses/%s?%s", encode(repo), ref, params.Encode())
-	out := new(status)
-	res, err := s.client.do(ctx, "POST", path, nil, out)
-	return convertStatus(out), res, err
+	params.Set("url", input.Target)
+	if input.Secret != "" {
+		params.Set("token", input.Secret)
+	}
+	params.Set("enable_ssl_verification", strconv.FormatBool(!input.SkipVerify))
+	params.Set("issues_events", strconv.FormatBool(input.Events.Issue))
+	params.Set("note_events", strconv.FormatBool(
+		input.Events.IssueComment || input.Events.PullRequestComment,
+	))
+	params.Set("merge_requests_events", strconv.FormatBool(input.Events.PullRequest))
+	params.Set("push_events", strconv.FormatBool(input.Events.Push || input.Events.Branch))
+	params.Set("tag_push_events", strconv.FormatBool(input.Events.Tag))
+	params.Set("pipeline_events", strconv.FormatBool(input.Events.Pipeline))
+	params.Set("job_events", strconv.FormatBool(input.Events.Pipeline))
+	params.Set("releases_events", strconv.FormatBool(input.Events.Release))
+	params.Set("wiki_page_events", strconv.FormatBool(input.Events.Wiki))
+	params.Set("member_events", strconv.FormatBool(input.Events.Member))
+	return params
 }
 
-func (s *repositoryService) UpdateHook(ctx context.Context, repo string, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// helper function to convert from the gitlab hook event
+// list to the common hook delivery structure.
+func convertHookEventList(from []*hookEvent) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookEvent(v))
+	}
+	return to
 }
 
-func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id string) (*scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s", encode(repo), id)
-	return s.client.do(ctx, "DELETE", path, nil, nil)
+// helper function to convert from the gitlab hook event
+// structure to the common hook delivery structure.
+func convertHookEvent(from *hookEvent) *scm.HookDelivery {
+	// the response status is a string because it holds
+	// the error message if the request could not be sent.
+	status, _ := strconv.Atoi(from.ResponseStatus)
+	to := &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		Event:    from.Trigger,
+		Status:   status,
+		Duration: time.Duration(from.ExecutionDuration * float64(time.Second)),
+		Response: from.ResponseBody,
+	}
+	if len(from.RequestData) != 0 && string(from.RequestData) != "null" {
+		to.Request = string(from.RequestData)
+	}
+	return to
+}
+
+// helper function to convert from the gitlab language
+// percentages to the common language structure. GitLab
+// does not report byte counts.
+func convertLanguageList(from map[string]float64) []*scm.Language {
+	to := []*scm.Language{}
+	for k, v := range from {
+		to = append(to, &scm.Language{
+			Name:    k,
+			Percent: v,
+		})
+	}
+	sort.Slice(to, func(i, j int) bool {
+		if to[i].Percent == to[j].Percent {
+			return to[i].Name < to[j].Name
+		}
+		return to[i].Percent > to[j].Percent
+	})
+	return to
 }
 
 // helper function to convert from the gogs repository list to
@@ -190,14 +368,30 @@ func convertRepository(from *repository) *scm.Repository {
 			Push:  canPush(from),
 			Admin: canAdmin(from),
 		},
+		Description: from.Description.String,
+		Archived:    from.Archived,
+		Stars:       from.StarCount,
+		OpenIssues:  from.OpenIssues,
+		Size:        from.Statistics.RepositorySize / 1024,
+	}
+	// the project does not include the primary language,
+	// which is only available from ListLanguages.
+	// the tag_list field is deprecated in favor of topics,
+	// but is the only field returned by older versions.
+	if len(from.Topics) != 0 {
+		to.Topics = from.Topics
+	} else if len(from.TagList) != 0 {
+		to.Topics = from.TagList
+	}
+	if from.ForkedFrom != nil {
+		to.Fork = true
+		to.Parent = from.ForkedFrom.PathNamespace
 	}
 	if path := from.Namespace.FullPath; path != "" {
 		to.Namespace = path
 	}
 	if to.Namespace == "" {
-		if parts := strings.SplitN(from.PathNamespace, "/", 2); len(parts) == 2 {
-			to.Namespace = parts[1]
-		}
+		to.Namespace, _ = scm.SplitNamespace(from.PathNamespace)
 	}
 	return to
 }
@@ -265,6 +459,18 @@ func convertEvents(from *hook) []string {
 	if from.MergeRequestsEvents {
 		events = append(events, "merge")
 	}
+	if from.PipelineEvents || from.JobEvents {
+		events = append(events, "pipeline")
+	}
+	if from.ReleasesEvents {
+		events = append(events, "release")
+	}
+	if from.WikiPageEvents {
+		events = append(events, "wiki")
+	}
+	if from.MemberEvents {
+		events = append(events, "member")
+	}
 	return events
 }
 
@@ -24,6 +24,7 @@ func TestRepositoryFind(t *testing.T) {
 
 	gock.New("https://gitlab.com").
 		Get("/api/v4/projects/diaspora/diaspora").
+		MatchParam("statistics", "true").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -76,6 +77,33 @@ func TestSubgroupFind(t *testing.T) {
 	}
 }
 
+func TestRepositoryFindByID(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/178504").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.Find(context.Background(), "178504")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Repository)
+	raw, _ := ioutil.ReadFile("testdata/repo.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestRepositoryPerms(t *testing.T) {
 	defer gock.Off()
 
@@ -136,6 +164,7 @@ func TestRepositoryList(t *testing.T) {
 		MatchParam("page", "1").
 		MatchParam("per_page", "30").
 		MatchParam("membership", "true").
+		MatchParam("statistics", "true").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -237,6 +266,100 @@ func TestStatusCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryTopicList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListTopics(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryTopicReplace(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"topics": []string{"ruby", "social"},
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ReplaceTopics(context.Background(), "diaspora/diaspora", []string{"ruby", "social"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []string{}
+	raw, _ := ioutil.ReadFile("testdata/topics.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryLanguageList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/languages").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/languages.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListLanguages(context.Background(), "diaspora/diaspora")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Language{}
+	raw, _ := ioutil.ReadFile("testdata/languages.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestRepositoryHookFind(t *testing.T) {
 	defer gock.Off()
 
@@ -364,6 +487,37 @@ func TestRepositoryHookCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookCreate_Events(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks").
+		MatchParam("pipeline_events", "true").
+		MatchParam("job_events", "true").
+		MatchParam("releases_events", "true").
+		MatchParam("wiki_page_events", "true").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Name:   "drone",
+		Target: "https://ci.example.com/hook",
+		Events: scm.HookEvents{
+			Pipeline: true,
+			Release:  true,
+			Wiki:     true,
+		},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Repositories.CreateHook(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestRepositoryHookCreate_SkipVerification(t *testing.T) {
 	defer gock.Off()
 
@@ -404,6 +558,176 @@ func TestRepositoryHookCreate_SkipVerification(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/hooks/1").
+		MatchParam("url", "https://ci.example.com/hook").
+		MatchParam("token", "topsecret").
+		MatchParam("enable_ssl_verification", "true").
+		MatchParam("push_events", "true").
+		MatchParam("tag_push_events", "true").
+		MatchParam("issues_events", "true").
+		MatchParam("note_events", "true").
+		MatchParam("merge_requests_events", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook.json")
+
+	in := &scm.HookInput{
+		Name:   "drone",
+		Target: "https://ci.example.com/hook",
+		Secret: "topsecret",
+		Events: scm.HookEvents{
+			Issue:        true,
+			IssueComment: true,
+			PullRequest:  true,
+			Push:         true,
+			Tag:          true,
+		},
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.UpdateHook(context.Background(), "diaspora/diaspora", "1", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/hook.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookUpdate_DisableEvents(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/hooks/1").
+		MatchParam("url", "https://ci.example.com/hook").
+		MatchParam("enable_ssl_verification", "false").
+		MatchParam("push_events", "true").
+		MatchParam("tag_push_events", "false").
+		MatchParam("issues_events", "false").
+		MatchParam("note_events", "false").
+		MatchParam("merge_requests_events", "false").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_skip_verification.json")
+
+	in := &scm.HookInput{
+		Target:     "https://ci.example.com/hook",
+		SkipVerify: true,
+		Events:     scm.HookEvents{Push: true},
+	}
+
+	client := NewDefault()
+	_, _, err := client.Repositories.UpdateHook(context.Background(), "diaspora/diaspora", "1", in)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/hooks/1/events").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_events.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListHookDeliveries(context.Background(), "diaspora/diaspora", "1", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/hook_events.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookDeliveryFind(t *testing.T) {
+	_, _, err := NewDefault().Repositories.FindHookDelivery(context.Background(), "diaspora/diaspora", "1", "1")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryHookRedeliver(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks/1/events/2/resend").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"response_status":200}`)
+
+	client := NewDefault()
+	res, err := client.Repositories.RedeliverHook(context.Background(), "diaspora/diaspora", "1", "2")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 201; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/hooks/1/test/push_events").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"201 Created"}`)
+
+	client := NewDefault()
+	res, err := client.Repositories.PingHook(context.Background(), "diaspora/diaspora", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 201; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
@@ -0,0 +1,194 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.RepositorySearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	params := url.Values{}
+	if opts.Query != "" {
+		params.Set("search", opts.Query)
+	}
+	if opts.Topic != "" {
+		params.Set("topic", opts.Topic)
+	}
+	if opts.Language != "" {
+		params.Set("with_programming_language", opts.Language)
+	}
+	encodeSearchPage(params, opts.Page, opts.Size)
+
+	path := fmt.Sprintf("api/v4/projects?%s", params.Encode())
+	if opts.Org != "" {
+		params.Set("include_subgroups", "true")
+		path = fmt.Sprintf("api/v4/groups/%s/projects?%s", encode(opts.Org), params.Encode())
+	}
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.CodeSearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	query := opts.Query
+	if opts.Path != "" {
+		query = query + " path:" + opts.Path
+	}
+	params := url.Values{}
+	params.Set("scope", "blobs")
+	params.Set("search", strings.TrimSpace(query))
+	encodeSearchPage(params, opts.Page, opts.Size)
+
+	var path string
+	switch {
+	case opts.Repo != "":
+		path = fmt.Sprintf("api/v4/projects/%s/search?%s", encode(opts.Repo), params.Encode())
+	case opts.Org != "":
+		path = fmt.Sprintf("api/v4/groups/%s/search?%s", encode(opts.Org), params.Encode())
+	default:
+		path = fmt.Sprintf("api/v4/search?%s", params.Encode())
+	}
+	out := []*blob{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCodeResultList(out, opts.Repo), res, err
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.IssueSearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	params := url.Values{}
+	if opts.Query != "" {
+		params.Set("search", opts.Query)
+	}
+	if opts.Author != "" {
+		params.Set("author_username", opts.Author)
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Open && !opts.Closed {
+		params.Set("state", "opened")
+	} else if opts.Closed && !opts.Open {
+		params.Set("state", "closed")
+	}
+	if !opts.Since.IsZero() {
+		params.Set("updated_after", opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("updated_before", opts.Until.UTC().Format(scm.SearchTimeFormat))
+	}
+	encodeSearchPage(params, opts.Page, opts.Size)
+
+	resource := "issues"
+	if opts.PullRequest {
+		resource = "merge_requests"
+	}
+	var path string
+	switch {
+	case opts.Repo != "":
+		path = fmt.Sprintf("api/v4/projects/%s/%s?%s", encode(opts.Repo), resource, params.Encode())
+	case opts.Org != "":
+		path = fmt.Sprintf("api/v4/groups/%s/%s?%s", encode(opts.Org), resource, params.Encode())
+	default:
+		params.Set("scope", "all")
+		path = fmt.Sprintf("api/v4/%s?%s", resource, params.Encode())
+	}
+
+	if opts.PullRequest {
+		out := []*pr{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		return convertPullRequestIssueList(out), res, err
+	}
+	out := []*issue{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueList(out), res, err
+}
+
+//
+// native data structures
+//
+
+type blob struct {
+	Basename  string `json:"basename"`
+	Data      string `json:"data"`
+	Path      string `json:"path"`
+	Filename  string `json:"filename"`
+	ID        string `json:"id"`
+	Ref       string `json:"ref"`
+	Startline int    `json:"startline"`
+	ProjectID int    `json:"project_id"`
+}
+
+//
+// native data structure conversion
+//
+
+func encodeSearchPage(params url.Values, page, size int) {
+	if page != 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if size != 0 {
+		params.Set("per_page", strconv.Itoa(size))
+	}
+}
+
+// helper function to convert from the gitlab blob list to
+// the common code result structure. The gitlab search api
+// only returns the numeric project id, which is used as the
+// repository name when searching across projects.
+func convertCodeResultList(from []*blob, repo string) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v, repo))
+	}
+	return to
+}
+
+func convertCodeResult(from *blob, repo string) *scm.CodeResult {
+	if repo == "" {
+		repo = strconv.Itoa(from.ProjectID)
+	}
+	return &scm.CodeResult{
+		Repo: repo,
+		Path: from.Path,
+		Ref:  from.Ref,
+		Fragments: []scm.Fragment{
+			{
+				Line: from.Startline,
+				Text: from.Data,
+			},
+		},
+	}
+}
+
+// helper function to convert from the gitlab merge request
+// list to the common issue structure.
+func convertPullRequestIssueList(from []*pr) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from {
+		pr := convertPullRequest(v)
+		to = append(to, &scm.Issue{
+			Number:      pr.Number,
+			Title:       pr.Title,
+			Body:        pr.Body,
+			Link:        pr.Link,
+			Labels:      v.Labels,
+			Closed:      pr.Closed,
+			Author:      pr.Author,
+			PullRequest: *pr,
+			Created:     pr.Created,
+			Updated:     pr.Updated,
+		})
+	}
+	return to
+}
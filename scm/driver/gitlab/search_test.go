@@ -0,0 +1,177 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/groups/diaspora/projects").
+		MatchParam("search", "diaspora").
+		MatchParam("topic", "ruby").
+		MatchParam("include_subgroups", "true").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_repos.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{
+		Query: "diaspora",
+		Topic: "ruby",
+		Org:   "diaspora",
+		Page:  1,
+		Size:  30,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/search_repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/search").
+		MatchParam("scope", "blobs").
+		MatchParam("search", "^installation path:README.md$").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_blobs.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Code(context.Background(), scm.CodeSearchOptions{
+		Query: "installation",
+		Repo:  "diaspora/diaspora",
+		Path:  "README.md",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := ioutil.ReadFile("testdata/search_blobs.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSearchIssues(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/issues").
+		MatchParam("scope", "all").
+		MatchParam("search", "bug").
+		MatchParam("author_username", "root").
+		MatchParam("labels", "bug,documentation").
+		MatchParam("state", "closed").
+		MatchParam("updated_after", "2017-01-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_issues.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{
+		Query:  "bug",
+		Author: "root",
+		Labels: []string{"bug", "documentation"},
+		Closed: true,
+		Since:  time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/search_issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchPullRequests(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests").
+		MatchParam("search", "fix").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_merges.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{
+		Query:       "fix",
+		Repo:        "diaspora/diaspora",
+		PullRequest: true,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/search_merges.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
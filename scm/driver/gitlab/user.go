@@ -7,7 +7,10 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -41,18 +44,187 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// FindByEmail returns the user account with a matching email
+// address. Non-admin users can only match public email addresses.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/users?search=%s", url.QueryEscape(email))
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertUser(out[0]), res, err
+}
+
+// ListEmails returns the authenticated user email addresses.
+// GitLab does not include the primary email address in the
+// list, so it is prepended to the first page of results.
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/emails?%s", encodeListOptions(opts))
+	out := []*email{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	emails := convertEmailList(out)
+	if opts.Page > 1 {
+		return emails, res, nil
+	}
+	primary, _, err := s.FindEmail(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	if primary != "" {
+		emails = append([]*scm.Email{{
+			Address:  primary,
+			Primary:  true,
+			Verified: true,
+		}}, emails...)
+	}
+	return emails, res, nil
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/keys?%s", encodeListOptions(opts))
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v4/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/user/gpg_keys?%s", encodeListOptions(opts))
+	out := []*gpgKey{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{
+		Key: input.Key,
+	}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "api/v4/user/gpg_keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/users/%s/projects?%s", login, encodeListOptions(opts))
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
+	ID       int         `json:"id"`
 	Username string      `json:"username"`
 	Name     string      `json:"name"`
 	Email    null.String `json:"email"`
 	Avatar   string      `json:"avatar_url"`
+	WebURL   string      `json:"web_url"`
+	Bot      bool        `json:"bot"`
+	IsAdmin  bool        `json:"is_admin"`
+	Created  null.Time   `json:"created_at"`
+}
+
+type email struct {
+	ID        int       `json:"id"`
+	Email     string    `json:"email"`
+	Confirmed null.Time `json:"confirmed_at"`
+}
+
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
+type gpgKey struct {
+	ID      int       `json:"id"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type gpgKeyInput struct {
+	Key string `json:"key"`
 }
 
 func convertUser(from *user) *scm.User {
-	return &scm.User{
-		Avatar: from.Avatar,
-		Email:  from.Email.String,
-		Login:  from.Username,
-		Name:   from.Name,
+	to := &scm.User{
+		ID:      strconv.Itoa(from.ID),
+		Avatar:  from.Avatar,
+		Email:   from.Email.String,
+		Login:   from.Username,
+		Name:    from.Name,
+		Link:    from.WebURL,
+		Type:    scm.UserTypeUser,
+		Admin:   from.IsAdmin,
+		Created: from.Created.Time,
+	}
+	if from.Bot {
+		to.Type = scm.UserTypeBot
+	}
+	return to
+}
+
+func convertEmailList(from []*email) []*scm.Email {
+	to := []*scm.Email{}
+	for _, v := range from {
+		to = append(to, &scm.Email{
+			Address:  v.Email,
+			Verified: v.Confirmed.Valid,
+		})
+	}
+	return to
+}
+
+func convertKeyList(from []*key) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:      from.ID,
+		Title:   from.Title,
+		Key:     from.Key,
+		Created: from.Created,
+	}
+}
+
+func convertGPGKeyList(from []*gpgKey) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	return &scm.GPGKey{
+		ID:      from.ID,
+		Key:     from.Key,
+		Created: from.Created,
 	}
 }
@@ -58,6 +58,18 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Ref != "" {
 		params.Set("ref_name", opts.Ref)
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(scm.SearchTimeFormat))
+	}
 	return params.Encode()
 }
 
@@ -94,6 +106,33 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	} else if opts.Open {
 		params.Set("state", "opened")
 	}
+	if opts.Merged {
+		params.Set("state", "merged")
+	}
+	if opts.Source != "" {
+		params.Set("source_branch", opts.Source)
+	}
+	if opts.Target != "" {
+		params.Set("target_branch", opts.Target)
+	}
+	if opts.Author != "" {
+		params.Set("author_username", opts.Author)
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if !opts.Since.IsZero() {
+		params.Set("updated_after", opts.Since.UTC().Format(scm.SearchTimeFormat))
+	}
+	switch opts.Sort {
+	case scm.SortByCreated:
+		params.Set("order_by", "created_at")
+	case scm.SortByUpdated:
+		params.Set("order_by", "updated_at")
+	}
+	if opts.Direction != scm.SortDirectionDefault {
+		params.Set("sort", opts.Direction.String())
+	}
 	return params.Encode()
 }
 
@@ -6,6 +6,7 @@ package gitlab
 
 import (
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -47,6 +48,21 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Filter(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Ref:    "master",
+		Path:   "docs",
+		Author: "octocat",
+		Since:  time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	want := "author=octocat&path=docs&ref_name=master&since=2017-01-01T00%3A00%3A00Z&until=2017-12-31T00%3A00%3A00Z"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -133,3 +149,22 @@ func Test_encodePullRequestListOptions_Closed(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodePullRequestListOptions_Filter(t *testing.T) {
+	t.Parallel()
+	opts := scm.PullRequestListOptions{
+		Merged:    true,
+		Source:    "feature/x",
+		Target:    "master",
+		Author:    "root",
+		Labels:    []string{"bug", "ui"},
+		Since:     time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		Sort:      scm.SortByUpdated,
+		Direction: scm.SortAsc,
+	}
+	want := "author_username=root&labels=bug%2Cui&order_by=updated_at&sort=asc&source_branch=feature%2Fx&state=merged&target_branch=master&updated_after=2017-01-01T00%3A00%3A00Z"
+	got := encodePullRequestListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded pr list options %q, got %q", want, got)
+	}
+}
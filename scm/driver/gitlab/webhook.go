@@ -121,7 +121,7 @@ func convertPushHook(src *pushHook) *scm.PushHook {
 				},
 			})
 	}
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitNamespace(src.Project.PathWithNamespace)
 	dst := &scm.PushHook{
 		Ref:    scm.ExpandRef(src.Ref, "refs/heads/"),
 		Before: src.Before,
@@ -176,7 +176,7 @@ func converBranchHook(src *pushHook) *scm.BranchHook {
 		action = scm.ActionDelete
 		commit = src.Before
 	}
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitNamespace(src.Project.PathWithNamespace)
 	return &scm.BranchHook{
 		Action: action,
 		Ref: scm.Reference{
@@ -209,7 +209,7 @@ func convertTagHook(src *pushHook) *scm.TagHook {
 		action = scm.ActionDelete
 		commit = src.Before
 	}
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	namespace, name := scm.SplitNamespace(src.Project.PathWithNamespace)
 	return &scm.TagHook{
 		Action: action,
 		Ref: scm.Reference{
@@ -249,11 +249,8 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 	case "update":
 		action = scm.ActionSync
 	}
-	fork := scm.Join(
-		src.ObjectAttributes.Source.Namespace,
-		src.ObjectAttributes.Source.Name,
-	)
-	namespace, name := scm.Split(src.Project.PathWithNamespace)
+	fork := src.ObjectAttributes.Source.PathWithNamespace
+	namespace, name := scm.SplitNamespace(src.Project.PathWithNamespace)
 	return &scm.PullRequestHook{
 		Action: action,
 		PullRequest: scm.PullRequest{
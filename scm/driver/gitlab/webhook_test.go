@@ -58,6 +58,20 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// push hooks delivered by a group hook for a
+		// project in a subgroup
+		{
+			event:  "Push Hook",
+			before: "testdata/webhooks/push_group.json",
+			after:  "testdata/webhooks/push_group.json.golden",
+			obj:    new(scm.PushHook),
+		},
+		{
+			event:  "Push Hook",
+			before: "testdata/webhooks/push_subgroup.json",
+			after:  "testdata/webhooks/push_subgroup.json.golden",
+			obj:    new(scm.PushHook),
+		},
 		// // issue hooks
 		// {
 		// 	event:  "issues",
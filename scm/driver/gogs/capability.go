@@ -0,0 +1,98 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+// Unsupported lists the operations of the Gogs client that
+// always return scm.ErrNotSupported because Gogs does not
+// provide an api endpoint. Operations are named after the
+// scm.Client service field and method (e.g. PullRequests.Merge).
+//
+// Some supported operations are limited by the Gogs api:
+//
+//	PullRequests.Find does not return the source and target
+//	branches, and returns scm.ErrNotFound for issues.
+//
+//	Git.ListCommits only lists the first page of the most recent
+//	commits of the default branch, and returns scm.ErrNotSupported
+//	if the ref, path, author, since, until or a page after the
+//	first is set.
+//
+//	Git.Diff downloads the diff from the web interface.
+//
+//	Contents.Create and Contents.Update require Gogs 0.13 or
+//	higher, and Contents.Update does not verify the blob id.
+//
+//	Organizations.ListTeamMembers requires a site administrator.
+//
+//	Search.Repositories returns scm.ErrNotSupported if the topic
+//	or language option is set.
+//
+//	Milestones.List and Releases.List are not paginated.
+var Unsupported = []string{
+	"Contents.Delete",
+	"Git.CreateBranch",
+	"Git.ListChanges",
+	"Git.CompareChanges",
+	"Git.CompareDiff",
+	"Issues.FindComment",
+	"Issues.Lock",
+	"Issues.Unlock",
+	"Linker.Blame",
+	"Linker.Release",
+	"Organizations.FindMembership",
+	"Organizations.ListMembers",
+	"Organizations.AddMember",
+	"Organizations.RemoveMember",
+	"Organizations.FindHook",
+	"Organizations.ListHooks",
+	"Organizations.CreateHook",
+	"Organizations.UpdateHook",
+	"Organizations.DeleteHook",
+	"PullRequests.FindComment",
+	"PullRequests.List",
+	"PullRequests.ListChanges",
+	"PullRequests.ListCommits",
+	"PullRequests.Diff",
+	"PullRequests.Create",
+	"PullRequests.Merge",
+	"PullRequests.Close",
+	"Releases.Find",
+	"Releases.FindByTag",
+	"Releases.Create",
+	"Releases.Update",
+	"Releases.UpdateByTag",
+	"Releases.Delete",
+	"Releases.DeleteByTag",
+	"Repositories.ListStatus",
+	"Repositories.ListTopics",
+	"Repositories.ListLanguages",
+	"Repositories.ReplaceTopics",
+	"Repositories.CreateStatus",
+	"Repositories.ListHookDeliveries",
+	"Repositories.FindHookDelivery",
+	"Repositories.RedeliverHook",
+	"Repositories.PingHook",
+	"Reviews.Find",
+	"Reviews.List",
+	"Reviews.Create",
+	"Reviews.Delete",
+	"Search.Code",
+	"Search.Issues",
+	"Users.FindByEmail",
+	"Users.ListGPGKeys",
+	"Users.CreateGPGKey",
+}
+
+// Supports returns true if the operation is supported by the
+// Gogs client. The operation is named after the scm.Client
+// service field and method (e.g. PullRequests.Merge).
+func Supports(operation string) bool {
+	for _, v := range Unsupported {
+		if v == operation {
+			return false
+		}
+	}
+	return true
+}
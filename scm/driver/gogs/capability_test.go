@@ -0,0 +1,56 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// TestUnsupported verifies every operation in the capability
+// list exists and returns scm.ErrNotSupported.
+func TestUnsupported(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	for _, operation := range Unsupported {
+		parts := strings.Split(operation, ".")
+		service := reflect.ValueOf(client).Elem().FieldByName(parts[0])
+		if !service.IsValid() {
+			t.Errorf("Unknown service %s", operation)
+			continue
+		}
+		method := service.MethodByName(parts[1])
+		if !method.IsValid() {
+			t.Errorf("Unknown method %s", operation)
+			continue
+		}
+		var args []reflect.Value
+		for i := 0; i < method.Type().NumIn(); i++ {
+			typ := method.Type().In(i)
+			if typ.String() == "context.Context" {
+				args = append(args, reflect.ValueOf(context.Background()))
+			} else {
+				args = append(args, reflect.Zero(typ))
+			}
+		}
+		out := method.Call(args)
+		err, _ := out[len(out)-1].Interface().(error)
+		if err != scm.ErrNotSupported {
+			t.Errorf("Expect Not Supported error for %s", operation)
+		}
+	}
+}
+
+func TestSupports(t *testing.T) {
+	if Supports("PullRequests.Merge") {
+		t.Errorf("Expect PullRequests.Merge not supported")
+	}
+	if !Supports("PullRequests.Find") {
+		t.Errorf("Expect PullRequests.Find supported")
+	}
+}
@@ -26,12 +26,23 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 	}, res, err
 }
 
+// Create creates a file. Gogs creates and updates files with
+// the same endpoint, which requires Gogs 0.13 or higher.
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentInput{
+		Message: params.Message,
+		Branch:  params.Branch,
+		Content: params.Data,
+	}
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
 }
 
+// Update updates a file. Gogs does not accept the sha of the
+// file being replaced, and the blob id and sha parameters are
+// ignored.
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	return s.Create(ctx, repo, path, params)
 }
 
 func (s *contentService) Delete(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
@@ -39,5 +50,60 @@ func (s *contentService) Delete(ctx context.Context, repo, path string, params *
 }
 
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s?ref=%s", repo, path, scm.TrimRef(ref))
+	out := []*content{}
+	res, err := s.client.do(ctx, "GET", endpoint, nil, &out)
+	return convertContentInfoList(out), res, err
+}
+
+//
+// native data structures
+//
+
+// gogs content object.
+type content struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Sha  string `json:"sha"`
+	Size int    `json:"size"`
+}
+
+// gogs content request object.
+type contentInput struct {
+	Message string `json:"message"`
+	Branch  string `json:"branch,omitempty"`
+	Content []byte `json:"content"`
+}
+
+//
+// native data structure conversion
+//
+
+func convertContentInfoList(from []*content) []*scm.ContentInfo {
+	to := []*scm.ContentInfo{}
+	for _, v := range from {
+		to = append(to, convertContentInfo(v))
+	}
+	return to
+}
+
+func convertContentInfo(from *content) *scm.ContentInfo {
+	to := &scm.ContentInfo{
+		Path:   from.Path,
+		BlobID: from.Sha,
+	}
+	switch from.Type {
+	case "file":
+		to.Kind = scm.ContentKindFile
+	case "dir":
+		to.Kind = scm.ContentKindDirectory
+	case "symlink":
+		to.Kind = scm.ContentKindSymlink
+	case "submodule":
+		to.Kind = scm.ContentKindGitlink
+	default:
+		to.Kind = scm.ContentKindUnsupported
+	}
+	return to
 }
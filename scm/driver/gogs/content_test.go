@@ -6,9 +6,12 @@ package gogs
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
 	"github.com/h2non/gock"
 )
 
@@ -41,18 +44,53 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/contents/README.md").
+		MatchType("json").
+		JSON(map[string]string{
+			"message": "add readme",
+			"branch":  "master",
+			"content": "SGVsbG8gV29ybGQK",
+		}).
+		Reply(201).
+		Type("application/json")
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Contents.Create(context.Background(), "gogits/gogs", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	params := &scm.ContentParams{
+		Message: "add readme",
+		Branch:  "master",
+		Data:    []byte("Hello World\n"),
+	}
+	_, err := client.Contents.Create(context.Background(), "gogits/gogs", "README.md", params)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Put("/api/v1/repos/gogits/gogs/contents/README.md").
+		MatchType("json").
+		JSON(map[string]string{
+			"message": "update readme",
+			"content": "SGVsbG8gV29ybGQK",
+		}).
+		Reply(201).
+		Type("application/json")
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Contents.Update(context.Background(), "gogits/gogs", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	params := &scm.ContentParams{
+		Message: "update readme",
+		Data:    []byte("Hello World\n"),
+		BlobID:  "95d9c0c4c3c4e6e4a4d9b0a9f9b5e5a5c5d5e5f5",
+	}
+	_, err := client.Contents.Update(context.Background(), "gogits/gogs", "README.md", params)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
@@ -65,9 +103,27 @@ func TestContentDelete(t *testing.T) {
 }
 
 func TestContentList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogs/gogs/contents/").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_list.json")
+
 	client, _ := New("https://try.gogs.io")
-	_, _, err := client.Contents.List(context.Background(), "gogits/gogs", "/", "master", scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Contents.List(context.Background(), "gogs/gogs", "", "master", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.ContentInfo{}
+	raw, _ := ioutil.ReadFile("testdata/content_list.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
@@ -7,6 +7,7 @@ package gogs
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -34,8 +35,20 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 	return convertCommit(out), res, err
 }
 
+// FindTag returns the tag. Gogs cannot fetch a single tag,
+// and the tag is found in the list of repository tags.
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	tags, res, err := s.ListTags(ctx, repo, scm.ListOptions{})
+	if err != nil {
+		return nil, res, err
+	}
+	name = scm.TrimRef(name)
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
 }
 
 func (s *gitService) ListBranches(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -45,12 +58,28 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, _ scm.ListOp
 	return convertBranchList(out), res, err
 }
 
-func (s *gitService) ListCommits(ctx context.Context, repo string, _ scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// ListCommits returns the most recent commits of the default
+// branch, limited to the page size. Gogs does not support
+// listing the commits of other references, filtering by path,
+// author or date, or requesting subsequent pages, and
+// scm.ErrNotSupported is returned if any of these options
+// is set.
+func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	if opts.Ref != "" || opts.Path != "" || opts.Author != "" ||
+		!opts.Since.IsZero() || !opts.Until.IsZero() || opts.Page > 1 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/commits?%s", repo, encodeCommitListOptions(opts))
+	out := []*commitDetail{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/tags", repo)
+	out := []*tag{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTagList(out), res, err
 }
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
@@ -61,6 +90,18 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return nil, nil, scm.ErrNotSupported
 }
 
+// Diff returns the raw diff of the commit. Gogs does not
+// provide an api endpoint, and the diff is downloaded from
+// the web interface instead.
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	path := fmt.Sprintf("%s/commit/%s.%s", repo, ref, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, _ scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -72,6 +113,12 @@ type (
 		Commit commit `json:"commit"`
 	}
 
+	// gogs tag object.
+	tag struct {
+		Name   string `json:"name"`
+		Commit commit `json:"commit"`
+	}
+
 	// gogs commit object.
 	commit struct {
 		ID        string    `json:"id"`
@@ -125,13 +172,29 @@ func convertBranch(src *branch) *scm.Reference {
 	}
 }
 
-// func convertCommitList(src []*commit) []*scm.Commit {
-// 	dst := []*scm.Commit{}
-// 	for _, v := range src {
-// 		dst = append(dst, convertCommit(v))
-// 	}
-// 	return dst
-// }
+func convertTagList(src []*tag) []*scm.Reference {
+	dst := []*scm.Reference{}
+	for _, v := range src {
+		dst = append(dst, convertTag(v))
+	}
+	return dst
+}
+
+func convertTag(src *tag) *scm.Reference {
+	return &scm.Reference{
+		Name: scm.TrimRef(src.Name),
+		Path: scm.ExpandRef(src.Name, "refs/tags/"),
+		Sha:  src.Commit.ID,
+	}
+}
+
+func convertCommitList(src []*commitDetail) []*scm.Commit {
+	dst := []*scm.Commit{}
+	for _, v := range src {
+		dst = append(dst, convertCommit(v))
+	}
+	return dst
+}
 
 func convertCommit(src *commitDetail) *scm.Commit {
 	return &scm.Commit{
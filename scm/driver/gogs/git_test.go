@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/google/go-cmp/cmp"
@@ -45,8 +46,74 @@ func TestCommitFind(t *testing.T) {
 }
 
 func TestCommitList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogs/gogs/commits").
+		MatchParam("pageSize", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_list.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Size: 30})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commit_list.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestCommitListRef(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Ref: "develop"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitListPath(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
-	_, _, err := client.Git.ListCommits(context.Background(), "gogits/gogs", scm.CommitListOptions{})
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Path: "docs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitListAuthor(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Author: "unknwon"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitListSince(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Since: time.Now()})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitListUntil(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Until: time.Now()})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitListPage(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "gogs/gogs", scm.CommitListOptions{Page: 2})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
@@ -60,6 +127,31 @@ func TestChangeList(t *testing.T) {
 	}
 }
 
+func TestCommitDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/gogits/gogs/commit/f05f642b892d59a0a9ef6a31f6c905a24b5db13a.diff").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/commit.diff")
+
+	client, _ := New("https://try.gogs.io")
+	rc, _, err := client.Git.Diff(context.Background(), "gogits/gogs", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.DiffOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	want, _ := ioutil.ReadFile("testdata/commit.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 //
 // branch sub-tests
 //
@@ -119,17 +211,67 @@ func TestBranchList(t *testing.T) {
 //
 
 func TestTagFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogs/gogs/tags").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tags.json")
+
 	client, _ := New("https://try.gogs.io")
-	_, _, err := client.Git.FindTag(context.Background(), "gogits/gogs", "v1.0.0")
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.FindTag(context.Background(), "gogs/gogs", "v0.11.79")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/tag.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTagFindNotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogs/gogs/tags").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tags.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Git.FindTag(context.Background(), "gogs/gogs", "v1.0.0")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error")
 	}
 }
 
 func TestTagList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogs/gogs/tags").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tags.json")
+
 	client, _ := New("https://try.gogs.io")
-	_, _, err := client.Git.ListTags(context.Background(), "gogits/gogs", scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Git.ListTags(context.Background(), "gogs/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Reference{}
+	raw, _ := ioutil.ReadFile("testdata/tags.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
@@ -68,7 +68,11 @@ func (s *issueService) DeleteComment(ctx context.Context, repo string, index, id
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	in := &issueStateInput{
+		State: "closed",
+	}
+	return s.client.do(ctx, "PATCH", path, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -108,6 +112,11 @@ type (
 		Body  string `json:"body"`
 	}
 
+	// gogs issue state request object.
+	issueStateInput struct {
+		State string `json:"state"`
+	}
+
 	// gogs issue comment response object.
 	issueComment struct {
 		ID        int       `json:"id"`
@@ -100,10 +100,20 @@ func TestIssueCreate(t *testing.T) {
 }
 
 func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/issues/1").
+		MatchType("json").
+		JSON(map[string]string{"state": "closed"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
 	client, _ := New("https://try.gogs.io")
-	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Issues.Close(context.Background(), "gogits/gogs", 1)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
@@ -2,6 +2,8 @@ package gogs
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -11,21 +13,124 @@ type milestoneService struct {
 }
 
 func (s *milestoneService) Find(ctx context.Context, repo string, id int) (*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertMilestone(out), res, err
 }
 
+// List returns the repository milestones. Gogs does not
+// paginate or filter the milestone list, so the milestones
+// are filtered by state after they are fetched.
 func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/milestones", repo)
+	out := []*milestone{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMilestoneList(filterMilestoneList(out, opts)), res, err
 }
 
+// Create creates a milestone. Gogs always creates open
+// milestones, and the input state is ignored.
 func (s *milestoneService) Create(ctx context.Context, repo string, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/milestones", repo)
+	in := &milestoneInput{
+		Title:       input.Title,
+		Description: input.Description,
+	}
+	if !input.DueDate.IsZero() {
+		in.Deadline = &input.DueDate
+	}
+	out := new(milestone)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertMilestone(out), res, err
 }
 
 func (s *milestoneService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 func (s *milestoneService) Update(ctx context.Context, repo string, id int, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/milestones/%d", repo, id)
+	in := &milestoneInput{
+		Title:       input.Title,
+		Description: input.Description,
+	}
+	switch input.State {
+	case "open":
+		in.State = "open"
+	case "close", "closed":
+		in.State = "closed"
+	}
+	if !input.DueDate.IsZero() {
+		in.Deadline = &input.DueDate
+	}
+	out := new(milestone)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertMilestone(out), res, err
+}
+
+//
+// native data structures
+//
+
+type (
+	// gogs milestone response object.
+	milestone struct {
+		ID           int        `json:"id"`
+		Title        string     `json:"title"`
+		Description  string     `json:"description"`
+		State        string     `json:"state"`
+		OpenIssues   int        `json:"open_issues"`
+		ClosedIssues int        `json:"closed_issues"`
+		Closed       *time.Time `json:"closed_at"`
+		Deadline     *time.Time `json:"due_on"`
+	}
+
+	// gogs milestone request object.
+	milestoneInput struct {
+		Title       string     `json:"title,omitempty"`
+		Description string     `json:"description,omitempty"`
+		State       string     `json:"state,omitempty"`
+		Deadline    *time.Time `json:"due_on,omitempty"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+func filterMilestoneList(from []*milestone, opts scm.MilestoneListOptions) []*milestone {
+	if opts.Open == opts.Closed {
+		return from
+	}
+	to := []*milestone{}
+	for _, v := range from {
+		if (v.State == "closed") == opts.Closed {
+			to = append(to, v)
+		}
+	}
+	return to
+}
+
+func convertMilestoneList(from []*milestone) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+func convertMilestone(from *milestone) *scm.Milestone {
+	to := &scm.Milestone{
+		Number:      from.ID,
+		ID:          from.ID,
+		Title:       from.Title,
+		Description: from.Description,
+		State:       from.State,
+	}
+	if from.Deadline != nil {
+		to.DueDate = *from.Deadline
+	}
+	return to
 }
@@ -32,14 +32,82 @@ func (s *organizationService) List(ctx context.Context, _ scm.ListOptions) ([]*s
 	return convertOrgList(out), res, err
 }
 
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListTeams(ctx context.Context, name string, _ scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/teams", name)
+	out := []*team{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertTeamList(out), res, err
+}
+
+// ListTeamMembers returns the team members, where the team is
+// identified by its numeric id. Gogs only lists team members
+// through the admin api, which requires a site administrator.
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, id string, _ scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/admin/teams/%s/members", id)
+	out := []*user{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertMemberList(out), res, err
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, _ scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/orgs/%s/repos", name)
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
 
-type org struct {
-	Name   string `json:"username"`
-	Avatar string `json:"avatar_url"`
-}
+type (
+	// gogs organization response object.
+	org struct {
+		ID     int    `json:"id"`
+		Name   string `json:"username"`
+		Avatar string `json:"avatar_url"`
+	}
+
+	// gogs team response object.
+	team struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Permission  string `json:"permission"`
+	}
+)
 
 //
 // native data structure conversion
@@ -59,3 +127,31 @@ func convertOrg(from *org) *scm.Organization {
 		Avatar: from.Avatar,
 	}
 }
+
+func convertTeamList(from []*team) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from {
+		to = append(to, convertTeam(v))
+	}
+	return to
+}
+
+func convertTeam(from *team) *scm.Team {
+	return &scm.Team{
+		ID:          from.ID,
+		Name:        from.Name,
+		Slug:        from.Name,
+		Description: from.Description,
+	}
+}
+
+func convertMemberList(from []*user) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from {
+		to = append(to, &scm.Member{
+			User: *convertUser(v),
+			Role: scm.RoleMember,
+		})
+	}
+	return to
+}
@@ -73,3 +73,81 @@ func TestOrgList(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestOrgListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/orgs/gogits/teams").
+		Reply(200).
+		Type("application/json").
+		File("testdata/teams.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/teams.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/admin/teams/2/members").
+		Reply(200).
+		Type("application/json").
+		File("testdata/team_members.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "gogits", "2", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/team_members.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrgListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/orgs/gogits/repos").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Organizations.ListRepositories(context.Background(), "gogits", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverGogs, ParseURL)
+}
+
+// ParseURL parses a Gogs web or api url into a resource.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) > 2 && parts[0] == "api" && parts[1] == "v1" && parts[2] == "repos" {
+		return parseAPI(u, parts[3:])
+	}
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGogs,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + strings.TrimSuffix(parts[1], ".git"),
+	}
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pulls":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commit":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) >= 2 && rest[0] == "src":
+		switch rest[1] {
+		case "branch", "tag", "commit":
+			// gitea style urls are not valid gogs urls.
+			return nil, scm.ErrUnknownURL
+		}
+		res.Kind = scm.ResourceKindTree
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+		// the web url does not distinguish files from
+		// directories, however a line anchor is only
+		// valid for files.
+		if res.Lines = scm.ParseLineRange(u.Fragment); res.Lines.Start != 0 {
+			res.Kind = scm.ResourceKindBlob
+		}
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
+
+// parseAPI parses the path segments of a Gogs api url that
+// follow the api/v1/repos/ prefix.
+func parseAPI(u *url.URL, parts []string) (*scm.Resource, error) {
+	if len(parts) < 2 {
+		return nil, scm.ErrUnknownURL
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverGogs,
+		Host:   u.Host,
+		Repo:   parts[0] + "/" + parts[1],
+	}
+	var err error
+	rest := parts[2:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pulls":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) >= 2 && rest[0] == "issues":
+		res.Kind = scm.ResourceKindIssue
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) > 2 && rest[0] == "raw":
+		res.Kind = scm.ResourceKindBlob
+		res.Ref = rest[1]
+		res.Path = strings.Join(rest[2:], "/")
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
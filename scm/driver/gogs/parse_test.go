@@ -0,0 +1,76 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://try.gogs.io/gogits/gogs",
+			want: scm.Resource{Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://try.gogs.io/gogits/gogs/pulls/42",
+			want: scm.Resource{Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://try.gogs.io/gogits/gogs/issues/1",
+			want: scm.Resource{Kind: scm.ResourceKindIssue, Number: 1},
+		},
+		{
+			url:  "https://try.gogs.io/gogits/gogs/commit/f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+			want: scm.Resource{Kind: scm.ResourceKindCommit, Sha: "f05f642b892d59a0a9ef6a31f6c905a24b5db13a"},
+		},
+		{
+			url:  "https://try.gogs.io/gogits/gogs/src/master/docs",
+			want: scm.Resource{Kind: scm.ResourceKindTree, Ref: "master", Path: "docs"},
+		},
+		{
+			url:  "https://try.gogs.io/gogits/gogs/src/master/README.md#L10-L20",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://try.gogs.io/api/v1/repos/gogits/gogs/raw/master/README.md",
+			want: scm.Resource{Kind: scm.ResourceKindBlob, Ref: "master", Path: "README.md"},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverGogs
+		want.Host = "try.gogs.io"
+		want.Repo = "gogits/gogs"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://try.gogs.io/gogits",
+		"https://try.gogs.io/gogits/gogs/src/branch/master",
+		"https://try.gogs.io/gogits/gogs/pulls/foo",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package gogs
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -14,8 +16,22 @@ type pullService struct {
 	client *wrapper
 }
 
-func (s *pullService) Find(context.Context, string, int) (*scm.PullRequest, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// Find returns the pull request. Gogs does not provide a pull
+// request api, and the pull request is read from the issue
+// api, which does not include the source and target branches.
+// scm.ErrNotFound is returned if the issue is not a pull
+// request.
+func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.PullRequest, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if out.PullRequest == nil {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertPullRequestFromIssue(out), res, nil
 }
 
 func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
@@ -26,14 +42,18 @@ func (s *pullService) List(context.Context, string, scm.PullRequestListOptions)
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	return s.issues().ListComments(ctx, repo, number, opts)
 }
 
 func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) Diff(context.Context, string, int, scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListCommits(context.Context, string, int, scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -42,12 +62,12 @@ func (s *pullService) Create(context.Context, string, *scm.PullRequestInput) (*s
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	return s.issues().CreateComment(ctx, repo, number, input)
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	return s.issues().DeleteComment(ctx, repo, number, id)
 }
 
 func (s *pullService) Merge(context.Context, string, int) (*scm.Response, error) {
@@ -58,6 +78,12 @@ func (s *pullService) Close(context.Context, string, int) (*scm.Response, error)
 	return nil, scm.ErrNotSupported
 }
 
+// issues returns the issue service. Gogs pull request
+// comments are issue comments.
+func (s *pullService) issues() *issueService {
+	return &issueService{s.client}
+}
+
 //
 // native data structures
 //
@@ -2,6 +2,9 @@ package gogs
 
 import (
 	"context"
+	"fmt"
+	"time"
+
 	"github.com/drone/go-scm/scm"
 )
 
@@ -17,8 +20,14 @@ func (s *releaseService) FindByTag(ctx context.Context, repo string, tag string)
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *releaseService) List(ctx context.Context, repo string, opts scm.ReleaseListOptions) ([]*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// List returns the repository releases. Gogs does not
+// paginate the release list, and the list options are
+// ignored.
+func (s *releaseService) List(ctx context.Context, repo string, _ scm.ReleaseListOptions) ([]*scm.Release, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/releases", repo)
+	out := []*release{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReleaseList(out), res, err
 }
 
 func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
@@ -40,3 +49,43 @@ func (s *releaseService) Update(ctx context.Context, repo string, id int, input
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+//
+// native data structures
+//
+
+type release struct {
+	ID         int       `json:"id"`
+	TagName    string    `json:"tag_name"`
+	Target     string    `json:"target_commitish"`
+	Name       string    `json:"name"`
+	Body       string    `json:"body"`
+	Draft      bool      `json:"draft"`
+	Prerelease bool      `json:"prerelease"`
+	Created    time.Time `json:"created_at"`
+}
+
+//
+// native data structure conversion
+//
+
+func convertReleaseList(from []*release) []*scm.Release {
+	to := []*scm.Release{}
+	for _, v := range from {
+		to = append(to, convertRelease(v))
+	}
+	return to
+}
+
+func convertRelease(from *release) *scm.Release {
+	return &scm.Release{
+		ID:          from.ID,
+		Title:       from.Name,
+		Description: from.Body,
+		Tag:         from.TagName,
+		Commitish:   from.Target,
+		Draft:       from.Draft,
+		Prerelease:  from.Prerelease,
+		Created:     from.Created,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/releases").
+		Reply(200).
+		Type("application/json").
+		File("testdata/releases.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Releases.List(context.Background(), "gogits/gogs", scm.ReleaseListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -56,6 +56,18 @@ func (s *repositoryService) ListStatus(context.Context, string, string, scm.List
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) ListTopics(context.Context, string) ([]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ListLanguages(context.Context, string) ([]*scm.Language, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) ReplaceTopics(context.Context, string, []string) ([]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/hooks", repo)
 	in := new(hook)
@@ -99,6 +111,22 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) ListHookDeliveries(context.Context, string, string, scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) FindHookDelivery(context.Context, string, string, string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) RedeliverHook(context.Context, string, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) PingHook(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -106,19 +134,24 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 type (
 	// gogs repository resource.
 	repository struct {
-		ID            int       `json:"id"`
-		Owner         user      `json:"owner"`
-		Name          string    `json:"name"`
-		FullName      string    `json:"full_name"`
-		Private       bool      `json:"private"`
-		Fork          bool      `json:"fork"`
-		HTMLURL       string    `json:"html_url"`
-		SSHURL        string    `json:"ssh_url"`
-		CloneURL      string    `json:"clone_url"`
-		DefaultBranch string    `json:"default_branch"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		Permissions   perm      `json:"permissions"`
+		ID            int         `json:"id"`
+		Owner         user        `json:"owner"`
+		Name          string      `json:"name"`
+		FullName      string      `json:"full_name"`
+		Private       bool        `json:"private"`
+		Fork          bool        `json:"fork"`
+		HTMLURL       string      `json:"html_url"`
+		SSHURL        string      `json:"ssh_url"`
+		CloneURL      string      `json:"clone_url"`
+		DefaultBranch string      `json:"default_branch"`
+		CreatedAt     time.Time   `json:"created_at"`
+		UpdatedAt     time.Time   `json:"updated_at"`
+		Permissions   perm        `json:"permissions"`
+		Description   string      `json:"description"`
+		Size          int64       `json:"size"`
+		Stars         int         `json:"stars_count"`
+		OpenIssues    int         `json:"open_issues_count"`
+		Parent        *repository `json:"parent"`
 	}
 
 	// gogs permissions details.
@@ -158,17 +191,26 @@ func convertRepositoryList(src []*repository) []*scm.Repository {
 }
 
 func convertRepository(src *repository) *scm.Repository {
-	return &scm.Repository{
-		ID:        strconv.Itoa(src.ID),
-		Namespace: userLogin(&src.Owner),
-		Name:      src.Name,
-		Perm:      convertPerm(src.Permissions),
-		Branch:    src.DefaultBranch,
-		Private:   src.Private,
-		Clone:     src.CloneURL,
-		CloneSSH:  src.SSHURL,
-		Link:      src.HTMLURL,
+	dst := &scm.Repository{
+		ID:          strconv.Itoa(src.ID),
+		Namespace:   userLogin(&src.Owner),
+		Name:        src.Name,
+		Perm:        convertPerm(src.Permissions),
+		Branch:      src.DefaultBranch,
+		Private:     src.Private,
+		Clone:       src.CloneURL,
+		CloneSSH:    src.SSHURL,
+		Link:        src.HTMLURL,
+		Description: src.Description,
+		Size:        src.Size / 1024,
+		Stars:       src.Stars,
+		OpenIssues:  src.OpenIssues,
+		Fork:        src.Fork,
+	}
+	if src.Parent != nil {
+		dst.Parent = src.Parent.FullName
 	}
+	return dst
 }
 
 func convertPerm(src perm) *scm.Perm {
@@ -214,5 +256,8 @@ func convertHookEvent(from scm.HookEvents) []string {
 	if from.Push {
 		events = append(events, "push")
 	}
+	if from.Release {
+		events = append(events, "release")
+	}
 	return events
 }
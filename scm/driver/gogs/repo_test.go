@@ -259,6 +259,10 @@ func TestHookEvents(t *testing.T) {
 			in:  scm.HookEvents{PullRequest: true},
 			out: []string{"pull_request"},
 		},
+		{
+			in:  scm.HookEvents{Release: true},
+			out: []string{"release"},
+		},
 		{
 			in: scm.HookEvents{
 				Branch:             true,
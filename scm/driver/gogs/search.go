@@ -0,0 +1,67 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+// Repositories returns the repositories matching the keyword.
+// Gogs does not support searching by topic or language, and
+// scm.ErrNotSupported is returned if either option is set.
+func (s *searchService) Repositories(ctx context.Context, opts scm.RepositorySearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	if opts.Topic != "" || opts.Language != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	params := url.Values{}
+	params.Set("q", opts.Query)
+	if opts.Org != "" {
+		// gogs filters repositories by owner id, which
+		// requires looking up the organization first.
+		org := new(org)
+		res, err := s.client.do(ctx, "GET", fmt.Sprintf("api/v1/orgs/%s", opts.Org), nil, org)
+		if err != nil {
+			return nil, res, err
+		}
+		params.Set("uid", strconv.Itoa(org.ID))
+	}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("api/v1/repos/search?%s", params.Encode())
+	out := new(repositorySearchResult)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepositoryList(out.Data), res, err
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.CodeSearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Issues(ctx context.Context, opts scm.IssueSearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+//
+// native data structures
+//
+
+// gogs repository search response object.
+type repositorySearchResult struct {
+	Data []*repository `json:"data"`
+	OK   bool          `json:"ok"`
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/search").
+		MatchParam("q", "gogs").
+		MatchParam("page", "1").
+		MatchParam("limit", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_search.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{Query: "gogs", Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchRepositories_Org(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/orgs/gogits").
+		Reply(200).
+		Type("application/json").
+		File("testdata/organization.json")
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/search").
+		MatchParam("q", "gogs").
+		MatchParam("uid", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo_search.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{Query: "gogs", Org: "gogits"})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSearchRepositories_Topic(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{Topic: "go"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestSearchCode(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Search.Code(context.Background(), scm.CodeSearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -7,6 +7,8 @@ package gogs
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -33,6 +35,53 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+// FindByEmail is not supported. The Gogs user search only
+// matches the username and full name.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListEmails(ctx context.Context, _ scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	out := []*email{}
+	res, err := s.client.do(ctx, "GET", "api/v1/user/emails", nil, &out)
+	return convertEmailList(out), res, err
+}
+
+func (s *userService) ListKeys(ctx context.Context, _ scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	out := []*key{}
+	res, err := s.client.do(ctx, "GET", "api/v1/user/keys", nil, &out)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Title: input.Title,
+		Key:   input.Key,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "api/v1/user/keys", in, out)
+	return convertKey(out), res, err
+}
+
+// ListGPGKeys is not supported. Gogs does not support gpg
+// keys.
+func (s *userService) ListGPGKeys(ctx context.Context, _ scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CreateGPGKey is not supported. Gogs does not support gpg
+// keys.
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListRepositories(ctx context.Context, login string, _ scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/users/%s/repos", login)
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
 //
 // native data structures
 //
@@ -46,16 +95,36 @@ type user struct {
 	Avatar   string `json:"avatar_url"`
 }
 
+type email struct {
+	Email    string `json:"email"`
+	Verified bool   `json:"verified"`
+	Primary  bool   `json:"primary"`
+}
+
+type key struct {
+	ID      int       `json:"id"`
+	Title   string    `json:"title"`
+	Key     string    `json:"key"`
+	Created time.Time `json:"created_at"`
+}
+
+type keyInput struct {
+	Title string `json:"title"`
+	Key   string `json:"key"`
+}
+
 //
 // native data structure conversion
 //
 
 func convertUser(src *user) *scm.User {
 	return &scm.User{
+		ID:     strconv.Itoa(src.ID),
 		Login:  userLogin(src),
 		Avatar: src.Avatar,
 		Email:  src.Email,
 		Name:   src.Fullname,
+		Type:   scm.UserTypeUser,
 	}
 }
 
@@ -65,3 +134,32 @@ func userLogin(src *user) string {
 	}
 	return src.Login
 }
+
+func convertEmailList(src []*email) []*scm.Email {
+	dst := []*scm.Email{}
+	for _, v := range src {
+		dst = append(dst, &scm.Email{
+			Address:  v.Email,
+			Primary:  v.Primary,
+			Verified: v.Verified,
+		})
+	}
+	return dst
+}
+
+func convertKeyList(src []*key) []*scm.Key {
+	dst := []*scm.Key{}
+	for _, v := range src {
+		dst = append(dst, convertKey(v))
+	}
+	return dst
+}
+
+func convertKey(src *key) *scm.Key {
+	return &scm.Key{
+		ID:      src.ID,
+		Title:   src.Title,
+		Key:     src.Key,
+		Created: src.Created,
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gogs
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// encodeCommitListOptions encodes the commit list options.
+// Gogs only supports limiting the number of commits.
+func encodeCommitListOptions(opts scm.CommitListOptions) string {
+	params := url.Values{}
+	if opts.Size != 0 {
+		params.Set("pageSize", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
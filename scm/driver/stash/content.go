@@ -28,13 +28,33 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, name, path)
+	in := &contentCreateUpdate{
+		Content: params.Data,
+		Message: params.Message,
+		Branch:  params.Branch,
+	}
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
 }
 
+// Update updates the file. The params Sha must be the commit
+// id of the branch head, which Bitbucket Server uses to
+// detect conflicting changes to the file.
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	endpoint := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s", namespace, name, path)
+	in := &contentCreateUpdate{
+		Content:        params.Data,
+		Message:        params.Message,
+		Branch:         params.Branch,
+		SourceCommitID: params.Sha,
+	}
+	return s.client.do(ctx, "PUT", endpoint, in, nil)
 }
 
+// Delete is not supported. The Bitbucket Server rest api can
+// create and edit files, but cannot delete them.
 func (s *contentService) Delete(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -48,6 +68,14 @@ func (s *contentService) List(ctx context.Context, repo, path, ref string, opts
 	return convertContentInfoList(out), res, err
 }
 
+// contentCreateUpdate is sent as a multipart form.
+type contentCreateUpdate struct {
+	Content        []byte
+	Message        string
+	Branch         string
+	SourceCommitID string
+}
+
 type contents struct {
 	pagination
 	Values []string `json:"values"`
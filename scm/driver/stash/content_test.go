@@ -43,18 +43,80 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
-	content := new(contentService)
-	_, err := content.Create(context.Background(), "atlassian/atlaskit", "README", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		MatchHeader("Content-Type", "multipart/form-data").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	params := &scm.ContentParams{
+		Message: "create README",
+		Branch:  "master",
+		Data:    []byte("Hello World"),
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Contents.Create(context.Background(), "PRJ/my-repo", "README", params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
-	content := new(contentService)
-	_, err := content.Update(context.Background(), "atlassian/atlaskit", "README", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		MatchHeader("Content-Type", "multipart/form-data").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_update.json")
+
+	params := &scm.ContentParams{
+		Message: "update README",
+		Branch:  "master",
+		Data:    []byte("Hello World"),
+		Sha:     "5c64a07cd6c0f21b753bf261ef059c7e7633c50a",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Contents.Update(context.Background(), "PRJ/my-repo", "README", params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
+func TestContentUpdateConflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/browse/README").
+		Reply(409).
+		Type("application/json").
+		BodyString(`{"errors":[{"message":"The file has been modified since the specified commit."}]}`)
+
+	params := &scm.ContentParams{
+		Message: "update README",
+		Branch:  "master",
+		Data:    []byte("Hello World"),
+		Sha:     "5c64a07cd6c0f21b753bf261ef059c7e7633c50a",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Contents.Update(context.Background(), "PRJ/my-repo", "README", params)
+	if err == nil {
+		t.Errorf("Expect conflict error")
 	}
 }
 
@@ -7,6 +7,8 @@ package stash
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -19,8 +21,17 @@ type gitService struct {
 	client *wrapper
 }
 
+// CreateBranch creates the branch using the branch-utils rest
+// api, since the core rest api of Bitbucket Server cannot
+// create branches.
 func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.CreateBranch) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/branch-utils/1.0/projects/%s/repos/%s/branches", namespace, name)
+	in := &createBranch{
+		Name:       params.Name,
+		StartPoint: params.Sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
 }
 
 func (s *gitService) FindBranch(ctx context.Context, repo, branch string) (*scm.Reference, *scm.Response, error) {
@@ -74,11 +85,11 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
-	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits", namespace, name)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits?%s", namespace, name, encodeCommitListOptions(opts))
 	out := new(commits)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	copyPagination(out.pagination, res)
-	return convertCommitList(out), res, err
+	return filterCommits(convertCommitList(out), opts), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -99,6 +110,18 @@ func (s *gitService) ListChanges(ctx context.Context, repo, ref string, opts scm
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) Diff(ctx context.Context, repo, ref string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/%s?until=%s", namespace, name, opts.Format, url.QueryEscape(ref))
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
+func (s *gitService) CompareDiff(ctx context.Context, repo, source, target string, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/%s?since=%s&until=%s", namespace, name, opts.Format, url.QueryEscape(source), url.QueryEscape(target))
+	return s.client.stream(ctx, path, opts.MaxSize)
+}
+
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/compare/changes?from=%s&to=%s&%s", namespace, name, source, target, encodeListOptions(opts))
@@ -117,6 +140,11 @@ type branch struct {
 	IsDefault       bool   `json:"isDefault"`
 }
 
+type createBranch struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+}
+
 type commits struct {
 	pagination
 	Values []*commit `json:"values"`
@@ -142,9 +170,13 @@ type diffstat struct {
 		Extension  string   `json:"extension"`
 		ToString   string   `json:"toString"`
 	} `json:"path"`
+	SrcPath struct {
+		ToString string `json:"toString"`
+	} `json:"srcPath"`
 	PercentUnchanged int    `json:"percentUnchanged"`
 	Type             string `json:"type"`
 	NodeType         string `json:"nodeType"`
+	Executable       bool   `json:"executable"`
 	SrcExecutable    bool   `json:"srcExecutable"`
 	Links            struct {
 		Self []struct {
@@ -220,12 +252,25 @@ func convertDiffstats(from *diffstats) []*scm.Change {
 }
 
 func convertDiffstat(from *diffstat) *scm.Change {
-	return &scm.Change{
+	to := &scm.Change{
 		Path:    from.Path.ToString,
 		Added:   from.Type == "ADD",
 		Renamed: from.Type == "MOVE",
 		Deleted: from.Type == "DELETE",
 	}
+	if to.Renamed {
+		to.PrevPath = from.SrcPath.ToString
+	}
+	switch {
+	case to.Deleted:
+	case from.NodeType == "SUBMODULE":
+		to.Mode = "160000"
+	case from.Executable:
+		to.Mode = "100755"
+	default:
+		to.Mode = "100644"
+	}
+	return to
 }
 
 func convertCommitList(from *commits) []*scm.Commit {
@@ -236,6 +281,28 @@ func convertCommitList(from *commits) []*scm.Commit {
 	return to
 }
 
+// filterCommits applies the commit list filters that
+// cannot be expressed as bitbucket server query parameters.
+func filterCommits(from []*scm.Commit, opts scm.CommitListOptions) []*scm.Commit {
+	to := []*scm.Commit{}
+	for _, v := range from {
+		author := v.Author
+		switch {
+		case opts.Author != "" &&
+			opts.Author != author.Login &&
+			opts.Author != author.Name &&
+			opts.Author != author.Email:
+			continue
+		case !opts.Since.IsZero() && author.Date.Before(opts.Since):
+			continue
+		case !opts.Until.IsZero() && author.Date.After(opts.Until):
+			continue
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
 func convertCommit(from *commit) *scm.Commit {
 	return &scm.Commit{
 		Message: from.Message,
@@ -67,6 +67,36 @@ func TestGitFindBranch(t *testing.T) {
 	}
 }
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/branch-utils/1.0/projects/PRJ/repos/my-repo/branches").
+		MatchType("json").
+		JSON(map[string]string{
+			"name":       "feature",
+			"startPoint": "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_create.json")
+
+	params := &scm.CreateBranch{
+		Name: "feature",
+		Sha:  "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Git.CreateBranch(context.Background(), "PRJ/my-repo", params)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestGitFindTag(t *testing.T) {
 	defer gock.Off()
 
@@ -118,6 +148,59 @@ func TestGitListCommits(t *testing.T) {
 	}
 }
 
+func TestGitListCommitsFilter(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits").
+		MatchParam("until", "master").
+		MatchParam("path", "README.md").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.ListCommits(context.Background(), "PRJ/my-repo", scm.CommitListOptions{
+		Ref:    "master",
+		Path:   "README.md",
+		Author: "someone",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expect commits filtered by author, got %d", len(got))
+	}
+}
+
+func TestGitCompareDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/patch").
+		MatchParam("since", "master").
+		MatchParam("until", "feature/x").
+		MatchHeader("Accept", "text/plain").
+		Reply(200).
+		Type("text/plain").
+		File("testdata/pr.diff")
+
+	client, _ := New("http://example.com:7990")
+	rc, _, err := client.Git.CompareDiff(context.Background(), "PRJ/my-repo", "master", "feature/x", scm.DiffOptions{Format: scm.DiffFormatPatch})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	want, _ := ioutil.ReadFile("testdata/pr.diff")
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
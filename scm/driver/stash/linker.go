@@ -45,3 +45,55 @@ func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Refer
 	// an endpoint for evaluating diffs of two commits.
 	return "", scm.ErrNotSupported
 }
+
+// File returns a link to the file.
+func (l *linker) File(ctx context.Context, repo string, ref scm.Reference, path string, lines scm.LineRange) (string, error) {
+	namespace, name := scm.Split(repo)
+	at := ref.Sha
+	if at == "" {
+		at = ref.Path
+	}
+	return fmt.Sprintf("%sprojects/%s/repos/%s/browse/%s?at=%s%s", l.base, namespace, name, path, at, lineAnchor(lines)), nil
+}
+
+// Blame is not supported. Bitbucket Server does not provide
+// a link to the blame view.
+func (l *linker) Blame(ctx context.Context, repo string, ref scm.Reference, path string) (string, error) {
+	return "", scm.ErrNotSupported
+}
+
+// Issue is not supported. Bitbucket Server does not provide
+// an issue tracker.
+func (l *linker) Issue(ctx context.Context, repo string, number int) (string, error) {
+	return "", scm.ErrNotSupported
+}
+
+// PullRequestComment returns a link to the pull request comment.
+func (l *linker) PullRequestComment(ctx context.Context, repo string, number, id int) (string, error) {
+	namespace, name := scm.Split(repo)
+	return fmt.Sprintf("%sprojects/%s/repos/%s/pull-requests/%d/overview?commentId=%d", l.base, namespace, name, number, id), nil
+}
+
+// Release is not supported. Bitbucket Server does not
+// support releases.
+func (l *linker) Release(ctx context.Context, repo, tag string) (string, error) {
+	return "", scm.ErrNotSupported
+}
+
+// User returns a link to the user profile.
+func (l *linker) User(ctx context.Context, login string) (string, error) {
+	return fmt.Sprintf("%susers/%s", l.base, login), nil
+}
+
+// lineAnchor returns the url fragment that highlights the
+// range of lines.
+func lineAnchor(lines scm.LineRange) string {
+	switch {
+	case lines.Start == 0:
+		return ""
+	case lines.End == 0 || lines.End == lines.Start:
+		return fmt.Sprintf("#%d", lines.Start)
+	default:
+		return fmt.Sprintf("#%d-%d", lines.Start, lines.End)
+	}
+}
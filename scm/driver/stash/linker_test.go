@@ -93,3 +93,59 @@ func TestDiff(t *testing.T) {
 		t.Errorf("Expect ErrNotSupported when refpath is empty")
 	}
 }
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		link func(context.Context, scm.Linker) (string, error)
+		want string
+	}{
+		{
+			name: "File",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.File(ctx, "PRJ/hello-world", scm.Reference{Path: "refs/heads/master", Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"}, "README.md", scm.LineRange{Start: 10, End: 20})
+			},
+			want: "https://stash.acme.com/projects/PRJ/repos/hello-world/browse/README.md?at=a7389057b0eb027e73b32a81e3c5923a71d01dde#10-20",
+		},
+		{
+			name: "PullRequestComment",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.PullRequestComment(ctx, "PRJ/hello-world", 42, 1)
+			},
+			want: "https://stash.acme.com/projects/PRJ/repos/hello-world/pull-requests/42/overview?commentId=1",
+		},
+		{
+			name: "User",
+			link: func(ctx context.Context, l scm.Linker) (string, error) {
+				return l.User(ctx, "octocat")
+			},
+			want: "https://stash.acme.com/users/octocat",
+		},
+	}
+
+	client, _ := New("https://stash.acme.com")
+	for _, test := range tests {
+		got, err := test.link(context.Background(), client.Linker)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if want := test.want; got != want {
+			t.Errorf("%s: want link %q, got %q", test.name, want, got)
+		}
+	}
+}
+
+func TestLinksNotSupported(t *testing.T) {
+	client, _ := New("https://stash.acme.com")
+	ctx, l := context.Background(), client.Linker
+	if _, err := l.Blame(ctx, "PRJ/hello-world", scm.Reference{Path: "refs/heads/master"}, "README.md"); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for Blame")
+	}
+	if _, err := l.Issue(ctx, "PRJ/hello-world", 42); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for Issue")
+	}
+	if _, err := l.Release(ctx, "PRJ/hello-world", "v1.0.0"); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for Release")
+	}
+}
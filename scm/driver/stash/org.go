@@ -6,6 +6,8 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -25,3 +27,182 @@ func (s *organizationService) FindMembership(ctx context.Context, name, username
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+// ListMembers returns the users granted permission on the
+// project, where the name is the project key.
+func (s *organizationService) ListMembers(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/users?%s", name, encodeListOptions(opts))
+	out := new(userPermissions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertMemberList(out), res, err
+}
+
+// ListTeams returns the user groups granted permission on the
+// project, where the name is the project key.
+func (s *organizationService) ListTeams(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Team, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/groups?%s", name, encodeListOptions(opts))
+	out := new(groupPermissions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertTeamList(out), res, err
+}
+
+// ListTeamMembers returns the members of the user group. This
+// requires the authenticated user to have admin permission.
+func (s *organizationService) ListTeamMembers(ctx context.Context, name, team string, opts scm.ListOptions) ([]*scm.Member, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("context", team)
+	path := fmt.Sprintf("rest/api/1.0/admin/groups/more-members?%s&%s", params.Encode(), encodeListOptions(opts))
+	out := new(users)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertGroupMemberList(out), res, err
+}
+
+func (s *organizationService) ListRepositories(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos?%s", name, encodeListOptions(opts))
+	out := new(repositories)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertRepositoryList(out), res, err
+}
+
+// AddMember grants the user write permission on the project,
+// or admin permission if the role is admin.
+func (s *organizationService) AddMember(ctx context.Context, name, username string, role scm.Role) (*scm.Membership, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("name", username)
+	params.Set("permission", "PROJECT_WRITE")
+	if role == scm.RoleAdmin {
+		params.Set("permission", "PROJECT_ADMIN")
+	}
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/users?%s", name, params.Encode())
+	res, err := s.client.do(ctx, "PUT", path, nil, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Membership{Active: true, Role: role}, res, nil
+}
+
+// RemoveMember revokes all project permissions of the user.
+func (s *organizationService) RemoveMember(ctx context.Context, name, username string) (*scm.Response, error) {
+	params := url.Values{}
+	params.Set("name", username)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/permissions/users?%s", name, params.Encode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// FindHook returns a project webhook.
+func (s *organizationService) FindHook(ctx context.Context, name, id string) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks/%s", name, id)
+	out := new(hook)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHook(out), res, err
+}
+
+// ListHooks returns the project webhooks.
+func (s *organizationService) ListHooks(ctx context.Context, name string, opts scm.ListOptions) ([]*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks?%s", name, encodeListOptions(opts))
+	out := new(hooks)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertHookList(out), res, err
+}
+
+// CreateHook creates a project webhook, which receives
+// events for all repositories in the project.
+func (s *organizationService) CreateHook(ctx context.Context, name string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks", name)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil && isUnknownHookEvent(err) {
+		downgradeHookInput(in)
+		res, err = s.client.do(ctx, "POST", path, in, out)
+	}
+	return convertHook(out), res, err
+}
+
+// UpdateHook updates a project webhook.
+func (s *organizationService) UpdateHook(ctx context.Context, name, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks/%s", name, id)
+	in := convertFromHookInput(input)
+	out := new(hook)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil && isUnknownHookEvent(err) {
+		downgradeHookInput(in)
+		res, err = s.client.do(ctx, "PUT", path, in, out)
+	}
+	return convertHook(out), res, err
+}
+
+// DeleteHook deletes a project webhook.
+func (s *organizationService) DeleteHook(ctx context.Context, name, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/webhooks/%s", name, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type userPermissions struct {
+	pagination
+	Values []*userPermission `json:"values"`
+}
+
+type userPermission struct {
+	User       *user  `json:"user"`
+	Permission string `json:"permission"`
+}
+
+type groupPermissions struct {
+	pagination
+	Values []*groupPermission `json:"values"`
+}
+
+type groupPermission struct {
+	Group struct {
+		Name string `json:"name"`
+	} `json:"group"`
+	Permission string `json:"permission"`
+}
+
+type users struct {
+	pagination
+	Values []*user `json:"values"`
+}
+
+func convertMemberList(from *userPermissions) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from.Values {
+		member := &scm.Member{
+			User: *convertUser(v.User),
+			Role: scm.RoleMember,
+		}
+		if v.Permission == "PROJECT_ADMIN" {
+			member.Role = scm.RoleAdmin
+		}
+		to = append(to, member)
+	}
+	return to
+}
+
+func convertGroupMemberList(from *users) []*scm.Member {
+	to := []*scm.Member{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Member{
+			User: *convertUser(v),
+			Role: scm.RoleMember,
+		})
+	}
+	return to
+}
+
+func convertTeamList(from *groupPermissions) []*scm.Team {
+	to := []*scm.Team{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Team{
+			Name: v.Group.Name,
+			Slug: v.Group.Name,
+		})
+	}
+	return to
+}
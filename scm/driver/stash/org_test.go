@@ -6,9 +6,14 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestOrganizationFind(t *testing.T) {
@@ -34,3 +39,301 @@ func TestOrganizationList(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestOrganizationListMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/permissions/users").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/project_users.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListMembers(context.Background(), "PRJ", scm.ListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/project_users.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeams(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/permissions/groups").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/project_groups.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListTeams(context.Background(), "PRJ", scm.ListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Team{}
+	raw, _ := ioutil.ReadFile("testdata/project_groups.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListTeamMembers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/admin/groups/more-members").
+		MatchParam("context", "developers").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/group_members.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListTeamMembers(context.Background(), "PRJ", "developers", scm.ListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Member{}
+	raw, _ := ioutil.ReadFile("testdata/group_members.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos").
+		MatchParam("limit", "25").
+		MatchParam("start", "50").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Organizations.ListRepositories(context.Background(), "PRJ", scm.ListOptions{Page: 3, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Page.First, 1; got != want {
+		t.Errorf("Want Page.First %d, got %d", want, got)
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationAddMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/permissions/users").
+		MatchParam("name", "jcitizen").
+		MatchParam("permission", "PROJECT_ADMIN").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.AddMember(context.Background(), "PRJ", "jcitizen", scm.RoleAdmin)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Membership{Active: true, Role: scm.RoleAdmin}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationRemoveMember(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/permissions/users").
+		MatchParam("name", "jcitizen").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	res, err := client.Organizations.RemoveMember(context.Background(), "PRJ", "jcitizen")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestOrganizationHookFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.FindHook(context.Background(), "PRJ", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/webhooks").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhooks.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.ListHooks(context.Background(), "PRJ", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Hook{}
+	raw, _ := ioutil.ReadFile("testdata/webhooks.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/webhooks").
+		Reply(201).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.CreateHook(context.Background(), "PRJ", &scm.HookInput{
+		Name:   "example",
+		Target: "http://example.com",
+		Secret: "12345",
+		Events: scm.HookEvents{
+			Branch:             true,
+			PullRequest:        true,
+			PullRequestComment: true,
+			Push:               true,
+			Tag:                true,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Organizations.UpdateHook(context.Background(), "PRJ", "1", &scm.HookInput{
+		Name:   "example",
+		Target: "http://example.com",
+		Secret: "12345",
+		Events: scm.HookEvents{
+			Branch:             true,
+			PullRequest:        true,
+			PullRequestComment: true,
+			Push:               true,
+			Tag:                true,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Hook)
+	raw, _ := ioutil.ReadFile("testdata/webhook.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationHookDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/webhooks/1").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Organizations.DeleteHook(context.Background(), "PRJ", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
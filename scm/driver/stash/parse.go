@@ -0,0 +1,82 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func init() {
+	scm.RegisterURLParser(scm.DriverStash, ParseURL)
+}
+
+// ParseURL parses a Bitbucket Server web or rest api url
+// into a resource. Personal repositories are returned with
+// the ~ prefixed user slug as the project key.
+func ParseURL(rawurl string) (*scm.Resource, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	// locate the projects/{key}/repos/{slug} segments, which
+	// may be preceded by the context path or the rest api
+	// prefix (e.g. rest/api/1.0).
+	index := -1
+	for i := 0; i+3 < len(parts); i++ {
+		if (parts[i] == "projects" || parts[i] == "users") && parts[i+2] == "repos" {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return nil, scm.ErrUnknownURL
+	}
+	namespace := parts[index+1]
+	if parts[index] == "users" {
+		namespace = "~" + namespace
+	}
+	res := &scm.Resource{
+		Driver: scm.DriverStash,
+		Host:   u.Host,
+		Repo:   namespace + "/" + parts[index+3],
+	}
+	at := u.Query().Get("at")
+	rest := parts[index+4:]
+	switch {
+	case len(rest) == 0:
+		res.Kind = scm.ResourceKindRepository
+	case len(rest) >= 2 && rest[0] == "pull-requests":
+		res.Kind = scm.ResourceKindPullRequest
+		res.Number, err = strconv.Atoi(rest[1])
+	case len(rest) == 2 && rest[0] == "commits":
+		res.Kind = scm.ResourceKindCommit
+		res.Sha = rest[1]
+	case len(rest) == 1 && rest[0] == "browse" && scm.IsTag(at):
+		res.Kind = scm.ResourceKindTag
+		res.Ref = scm.TrimRef(at)
+	case rest[0] == "browse" || rest[0] == "raw":
+		res.Kind = scm.ResourceKindTree
+		res.Ref = scm.TrimRef(at)
+		res.Path = strings.Join(rest[1:], "/")
+		// the web url does not distinguish files from
+		// directories, however a line anchor is only
+		// valid for files.
+		if res.Lines = scm.ParseLineRange(u.Fragment); res.Lines.Start != 0 || rest[0] == "raw" {
+			res.Kind = scm.ResourceKindBlob
+		}
+	default:
+		return nil, scm.ErrUnknownURL
+	}
+	if err != nil {
+		return nil, scm.ErrUnknownURL
+	}
+	return res, nil
+}
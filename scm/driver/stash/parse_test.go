@@ -0,0 +1,79 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want scm.Resource
+	}{
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo/browse",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindTree},
+		},
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindRepository},
+		},
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo/pull-requests/42/overview",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo/commits/131cb13f4aed12e725177bc4b7c28db67839bf9f",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindCommit, Sha: "131cb13f4aed12e725177bc4b7c28db67839bf9f"},
+		},
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo/browse/docs/README.md?at=refs/heads/master#10-20",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindBlob, Ref: "master", Path: "docs/README.md", Lines: scm.LineRange{Start: 10, End: 20}},
+		},
+		{
+			url:  "https://stash.acme.com/projects/PRJ/repos/my-repo/browse?at=refs/tags/v1.0.0",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindTag, Ref: "v1.0.0"},
+		},
+		{
+			url:  "https://stash.acme.com/users/jcitizen/repos/my-repo/pull-requests/1",
+			want: scm.Resource{Repo: "~jcitizen/my-repo", Kind: scm.ResourceKindPullRequest, Number: 1},
+		},
+		{
+			url:  "https://stash.acme.com/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/42",
+			want: scm.Resource{Repo: "PRJ/my-repo", Kind: scm.ResourceKindPullRequest, Number: 42},
+		},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("Cannot parse %s: %s", test.url, err)
+			continue
+		}
+		want := test.want
+		want.Driver = scm.DriverStash
+		want.Host = "stash.acme.com"
+		if diff := cmp.Diff(*got, want); diff != "" {
+			t.Errorf("Unexpected Results for %s", test.url)
+			t.Log(diff)
+		}
+	}
+}
+
+func TestParseURL_Unknown(t *testing.T) {
+	tests := []string{
+		"https://stash.acme.com/projects/PRJ",
+		"https://stash.acme.com/projects/PRJ/repos/my-repo/pull-requests/foo",
+		"https://stash.acme.com/projects/PRJ/repos/my-repo/settings",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err != scm.ErrUnknownURL {
+			t.Errorf("Expect unknown url error for %s", test)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package stash
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -34,14 +35,20 @@ func (s *pullService) FindComment(ctx context.Context, repo string, number int,
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
-	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", namespace, name)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests?%s", namespace, name, encodePullRequestListOptions(opts))
 	out := new(prs)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	if !out.pagination.LastPage.Bool {
 		res.Page.First = 1
 		res.Page.Next = opts.Page + 1
 	}
-	return convertPullRequests(out), res, err
+	return filterPullRequests(convertPullRequests(out), opts), res, err
+}
+
+func (s *pullService) Diff(ctx context.Context, repo string, number int, opts scm.DiffOptions) (io.ReadCloser, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d.%s", namespace, name, number, opts.Format)
+	return s.client.stream(ctx, path, opts.MaxSize)
 }
 
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
@@ -56,13 +63,18 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return convertDiffstats(out), res, err
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	// TODO(bradrydzewski) the challenge with comments is that we need to use
-	// the activities endpoint, which returns entries that may or may not be
-	// comments. This complicates how we handle counts and pagination.
-
-	// GET /rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities
-	return nil, nil, scm.ErrNotSupported
+// ListComments returns the general pull request comments.
+// Bitbucket Server only lists comments as part of the pull
+// request activity, so a page may contain fewer results than
+// requested. Comments anchored to a file are returned by the
+// review service.
+func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertActivityComments(out), res, err
 }
 
 func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
@@ -117,13 +129,19 @@ func (s *pullService) CreateComment(ctx context.Context, repo string, number int
 	return convertPullRequestComment(out), res, err
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	// TODO(bradrydzewski) the challenge with deleting comments is that we need to specify
-	// the comment version number. The proposal is to use 0 as the initial version number,
-	// and then to use expectedVersion on error and re-attempt the API call.
-
-	// DELETE /rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1?version=0
-	return nil, scm.ErrNotSupported
+// DeleteComment deletes the pull request comment. Bitbucket
+// Server requires the current comment version, which is
+// fetched before the comment is deleted.
+func (s *pullService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	path = fmt.Sprintf("%s?version=%d", path, out.Version)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 type pr struct {
@@ -211,6 +229,26 @@ func convertPullRequests(from *prs) []*scm.PullRequest {
 	return to
 }
 
+// filterPullRequests applies the pull request list filters
+// that cannot be expressed as bitbucket server query
+// parameters. Bitbucket server does not support pull request
+// labels, and the label filter is ignored.
+func filterPullRequests(from []*scm.PullRequest, opts scm.PullRequestListOptions) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		switch {
+		case opts.Source != "" && opts.Target != "" && v.Source != opts.Source:
+			continue
+		case opts.Author != "" && v.Author.Login != opts.Author:
+			continue
+		case !opts.Since.IsZero() && v.Updated.Before(opts.Since):
+			continue
+		}
+		to = append(to, v)
+	}
+	return to
+}
+
 func convertPullRequest(from *pr) *scm.PullRequest {
 	fork := scm.Join(
 		from.FromRef.Repository.Project.Key,
@@ -260,10 +298,11 @@ type pullRequestComment struct {
 			} `json:"self"`
 		} `json:"links"`
 	} `json:"author"`
-	CreatedDate         int64         `json:"createdDate"`
-	UpdatedDate         int64         `json:"updatedDate"`
-	Comments            []interface{} `json:"comments"`
-	Tasks               []interface{} `json:"tasks"`
+	Anchor              *commentAnchor `json:"anchor"`
+	CreatedDate         int64          `json:"createdDate"`
+	UpdatedDate         int64          `json:"updatedDate"`
+	Comments            []interface{}  `json:"comments"`
+	Tasks               []interface{}  `json:"tasks"`
 	PermittedOperations struct {
 		Editable  bool `json:"editable"`
 		Deletable bool `json:"deletable"`
@@ -271,7 +310,58 @@ type pullRequestComment struct {
 }
 
 type pullRequestCommentInput struct {
-	Text string `json:"text"`
+	Text   string         `json:"text"`
+	Anchor *commentAnchor `json:"anchor,omitempty"`
+}
+
+// commentAnchor attaches a pull request comment to a file, or
+// to a line of the file in the pull request diff.
+type commentAnchor struct {
+	Path     string `json:"path"`
+	Line     int    `json:"line,omitempty"`
+	LineType string `json:"lineType,omitempty"`
+	FileType string `json:"fileType,omitempty"`
+}
+
+type activity struct {
+	ID            int                 `json:"id"`
+	CreatedDate   int64               `json:"createdDate"`
+	Action        string              `json:"action"`
+	CommentAction string              `json:"commentAction"`
+	Comment       *pullRequestComment `json:"comment"`
+	CommentAnchor *commentAnchor      `json:"commentAnchor"`
+}
+
+type activities struct {
+	pagination
+	Values []*activity `json:"values"`
+}
+
+// addedComments returns the comments added in the pull
+// request activity, including comments anchored to a file.
+func addedComments(from *activities) []*pullRequestComment {
+	to := []*pullRequestComment{}
+	for _, v := range from.Values {
+		if v.Action != "COMMENTED" || v.CommentAction != "ADDED" || v.Comment == nil {
+			continue
+		}
+		if v.Comment.Anchor == nil {
+			v.Comment.Anchor = v.CommentAnchor
+		}
+		to = append(to, v.Comment)
+	}
+	return to
+}
+
+func convertActivityComments(from *activities) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range addedComments(from) {
+		if v.Anchor != nil {
+			continue
+		}
+		to = append(to, convertPullRequestComment(v))
+	}
+	return to
 }
 
 func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -32,8 +33,11 @@ type repository struct {
 			Self []link `json:"self"`
 		} `json:"links"`
 	} `json:"project"`
-	Public bool `json:"public"`
-	Links  struct {
+	Public      bool        `json:"public"`
+	Archived    bool        `json:"archived"`
+	Description string      `json:"description"`
+	Origin      *repository `json:"origin"`
+	Links       struct {
 		Clone []link `json:"clone"`
 		Self  []link `json:"self"`
 	} `json:"links"`
@@ -75,6 +79,21 @@ type hook struct {
 	} `json:"configuration"`
 }
 
+type hookInvocation struct {
+	ID       int    `json:"id"`
+	Event    string `json:"event"`
+	Duration int64  `json:"duration"`
+	Start    int64  `json:"start"`
+	Request  struct {
+		Body string `json:"body"`
+	} `json:"request"`
+	Result struct {
+		StatusCode  int    `json:"statusCode"`
+		Description string `json:"description"`
+		Body        string `json:"body"`
+	} `json:"result"`
+}
+
 type hookInput struct {
 	Name   string   `json:"name"`
 	Events []string `json:"events"`
@@ -93,6 +112,11 @@ type status struct {
 	Desc  string `json:"description"`
 }
 
+type statuses struct {
+	pagination
+	Values []*status `json:"values"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -192,6 +216,28 @@ func (s *repositoryService) ListHooks(ctx context.Context, repo string, opts scm
 
 // ListStatus returns a list of commit statuses.
 func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
+	path := fmt.Sprintf("rest/build-status/1.0/commits/%s?%s", ref, encodeListOptions(opts))
+	out := new(statuses)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertStatusList(out), res, err
+}
+
+// ListTopics is not supported. Bitbucket Server
+// repositories do not have topics.
+func (s *repositoryService) ListTopics(ctx context.Context, repo string) ([]string, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListLanguages is not supported. Bitbucket Server does
+// not detect repository languages.
+func (s *repositoryService) ListLanguages(ctx context.Context, repo string) ([]*scm.Language, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ReplaceTopics is not supported. Bitbucket Server
+// repositories do not have topics.
+func (s *repositoryService) ReplaceTopics(ctx context.Context, repo string, topics []string) ([]string, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
@@ -199,15 +245,7 @@ func (s *repositoryService) ListStatus(ctx context.Context, repo, ref string, op
 func (s *repositoryService) CreateHook(ctx context.Context, repo string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks", namespace, name)
-	in := new(hookInput)
-	in.URL = input.Target
-	in.Active = true
-	in.Name = input.Name
-	in.Config.Secret = input.Secret
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "POST", path, in, out)
 	if err != nil && isUnknownHookEvent(err) {
@@ -240,15 +278,7 @@ func (s *repositoryService) CreateStatus(ctx context.Context, repo, ref string,
 func (s *repositoryService) UpdateHook(ctx context.Context, repo, id string, input *scm.HookInput) (*scm.Hook, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s", namespace, name, id)
-	in := new(hookInput)
-	in.URL = input.Target
-	in.Active = true
-	in.Name = input.Name
-	in.Config.Secret = input.Secret
-	in.Events = append(
-		input.NativeEvents,
-		convertFromHookEvents(input.Events)...,
-	)
+	in := convertFromHookInput(input)
 	out := new(hook)
 	res, err := s.client.do(ctx, "PUT", path, in, out)
 	if err != nil && isUnknownHookEvent(err) {
@@ -265,6 +295,50 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// ListHookDeliveries returns the latest invocation of a
+// repository webhook. Bitbucket Server does not keep a full
+// delivery history, so the list has at most one item.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s/latest", namespace, name, id)
+	out := new(hookInvocation)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 204 {
+		// the webhook has not been invoked yet.
+		return []*scm.HookDelivery{}, res, nil
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	return []*scm.HookDelivery{convertHookInvocation(out)}, res, nil
+}
+
+// FindHookDelivery is not supported. Bitbucket Server does
+// not keep a delivery history.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported. Bitbucket Server does not
+// provide an endpoint to replay a webhook invocation.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// PingHook sends a test request to the repository webhook
+// target url.
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	hook, res, err := s.FindHook(ctx, repo, id)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("url", hook.Target)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/test?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -278,16 +352,23 @@ func convertRepositoryList(from *repositories) []*scm.Repository {
 // helper function to convert from the gogs repository structure
 // to the common repository structure.
 func convertRepository(from *repository) *scm.Repository {
-	return &scm.Repository{
-		ID:        strconv.Itoa(from.ID),
-		Name:      from.Slug,
-		Namespace: from.Project.Key,
-		Link:      extractSelfLink(from.Links.Self),
-		Branch:    "master",
-		Private:   !from.Public,
-		CloneSSH:  extractLink(from.Links.Clone, "ssh"),
-		Clone:     anonymizeLink(extractLink(from.Links.Clone, "http")),
+	to := &scm.Repository{
+		ID:          strconv.Itoa(from.ID),
+		Name:        from.Slug,
+		Namespace:   from.Project.Key,
+		Link:        extractSelfLink(from.Links.Self),
+		Branch:      "master",
+		Private:     !from.Public,
+		CloneSSH:    extractLink(from.Links.Clone, "ssh"),
+		Clone:       anonymizeLink(extractLink(from.Links.Clone, "http")),
+		Description: from.Description,
+		Archived:    from.Archived,
+	}
+	if from.Origin != nil {
+		to.Fork = true
+		to.Parent = from.Origin.Project.Key + "/" + from.Origin.Slug
 	}
+	return to
 }
 
 func extractLink(links []link, name string) (href string) {
@@ -323,6 +404,18 @@ func convertHookList(from *hooks) []*scm.Hook {
 	return to
 }
 
+func convertHookInvocation(from *hookInvocation) *scm.HookDelivery {
+	return &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		Event:    from.Event,
+		Status:   from.Result.StatusCode,
+		Duration: time.Duration(from.Duration) * time.Millisecond,
+		Created:  time.Unix(from.Start/1000, 0),
+		Request:  from.Request.Body,
+		Response: from.Result.Body,
+	}
+}
+
 func convertHook(from *hook) *scm.Hook {
 	return &scm.Hook{
 		ID:     strconv.Itoa(from.ID),
@@ -333,6 +426,22 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+// helper function to convert from the common hook input
+// structure to the stash hook input structure. It is shared
+// by the repository and project hook endpoints.
+func convertFromHookInput(from *scm.HookInput) *hookInput {
+	to := new(hookInput)
+	to.URL = from.Target
+	to.Active = true
+	to.Name = from.Name
+	to.Config.Secret = from.Secret
+	to.Events = append(
+		from.NativeEvents,
+		convertFromHookEvents(from.Events)...,
+	)
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push || from.Branch || from.Tag {
@@ -351,6 +460,14 @@ func convertFromHookEvents(from scm.HookEvents) []string {
 		events = append(events, "pr:comment:deleted")
 		events = append(events, "pr:comment:edited")
 	}
+	if from.PullRequestReview {
+		events = append(events, "pr:reviewer:approved")
+		events = append(events, "pr:reviewer:unapproved")
+		events = append(events, "pr:reviewer:needs_work")
+	}
+	if from.Repository {
+		events = append(events, "repo:modified")
+	}
 	return events
 }
 
@@ -368,6 +485,24 @@ func downgradeHookInput(in *hookInput) {
 	in.Events = events
 }
 
+func convertStatusList(from *statuses) []*scm.Status {
+	to := []*scm.Status{}
+	for _, v := range from.Values {
+		to = append(to, convertStatus(v))
+	}
+	return to
+}
+
+func convertStatus(from *status) *scm.Status {
+	return &scm.Status{
+		State:  convertState(from.State),
+		Label:  from.Key,
+		Title:  from.Name,
+		Desc:   from.Desc,
+		Target: from.URL,
+	}
+}
+
 func convertFromState(from scm.State) string {
 	switch from {
 	case scm.StatePending, scm.StateRunning:
@@ -290,10 +290,28 @@ func TestRepositoryList(t *testing.T) {
 }
 
 func TestStatusList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/build-status/1.0/commits/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
 	client, _ := New("http://example.com:7990")
-	_, _, err := client.Repositories.ListStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", scm.ListOptions{Size: 30, Page: 1})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Repositories.ListStatus(context.Background(), "PRJ/my-repo", "a6e5e7d797edf751cbd839d6bd4aef86c941eec9", scm.ListOptions{Size: 30, Page: 1})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Status{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
@@ -461,6 +479,85 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_invocation.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListHookDeliveries(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/webhook_invocation.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookDeliveryList_Empty(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/latest").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListHookDeliveries(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want empty delivery list, got %d deliveries", len(got))
+	}
+}
+
+func TestRepositoryHookRedeliver(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.RedeliverHook(context.Background(), "PRJ/my-repo", "1", "7")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/test").
+		MatchParam("url", "http://example.com").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"statusCode":200,"headers":{},"body":"ok"}`)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.PingHook(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
@@ -530,3 +627,51 @@ func TestConvertState(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryTopicList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListTopics(context.Background(), "atlassian/stash-example-plugin")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryTopicReplace(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ReplaceTopics(context.Background(), "atlassian/stash-example-plugin", []string{"java"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestRepositoryLanguageList(t *testing.T) {
+	_, _, err := NewDefault().Repositories.ListLanguages(context.Background(), "atlassian/stash-example-plugin")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestHookEvents(t *testing.T) {
+	tests := []struct {
+		in  scm.HookEvents
+		out []string
+	}{
+		{
+			in:  scm.HookEvents{Push: true},
+			out: []string{"repo:refs_changed"},
+		},
+		{
+			in:  scm.HookEvents{PullRequestReview: true},
+			out: []string{"pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work"},
+		},
+		{
+			in:  scm.HookEvents{Repository: true},
+			out: []string{"repo:modified"},
+		},
+	}
+	for i, test := range tests {
+		got, want := convertFromHookEvents(test.in), test.out
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("Unexpected Results at index %d", i)
+			t.Log(diff)
+		}
+	}
+}
@@ -6,26 +6,97 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// reviewService implements the review service using pull
+// request comments that are anchored to a file in the pull
+// request diff.
 type reviewService struct {
 	client *wrapper
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReview(out), res, err
 }
 
+// List returns the anchored pull request comments. Bitbucket
+// Server only lists comments as part of the pull request
+// activity, so a page may contain fewer results than requested.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/activities?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(activities)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertActivityReviews(out), res, err
 }
 
+// Create creates a pull request comment anchored to the file
+// path. If a line number is provided, the comment is anchored
+// to the line of the new version of the file, which must be
+// a line added by the pull request.
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := &pullRequestCommentInput{
+		Text: input.Body,
+		Anchor: &commentAnchor{
+			Path: input.Path,
+		},
+	}
+	if input.Line != 0 {
+		in.Anchor.Line = input.Line
+		in.Anchor.LineType = "ADDED"
+		in.Anchor.FileType = "TO"
+	}
+	out := new(pullRequestComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	return (&pullService{s.client}).DeleteComment(ctx, repo, number, id)
+}
+
+//
+// native data structure conversion
+//
+
+func convertActivityReviews(from *activities) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range addedComments(from) {
+		if v.Anchor == nil {
+			continue
+		}
+		to = append(to, convertReview(v))
+	}
+	return to
+}
+
+func convertReview(from *pullRequestComment) *scm.Review {
+	to := &scm.Review{
+		ID:      from.ID,
+		Body:    from.Text,
+		Created: time.Unix(from.CreatedDate/1000, 0),
+		Updated: time.Unix(from.UpdatedDate/1000, 0),
+		Author: scm.User{
+			Login:  from.Author.Slug,
+			Name:   from.Author.DisplayName,
+			Email:  from.Author.EmailAddress,
+			Avatar: avatarLink(from.Author.EmailAddress),
+		},
+	}
+	if from.Anchor != nil {
+		to.Path = from.Anchor.Path
+		to.Line = from.Anchor.Line
+	}
+	return to
 }
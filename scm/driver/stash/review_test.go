@@ -6,35 +6,142 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Find(context.Background(), "PRJ/my-repo", 1, 3)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comments.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.List(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 30})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewList_Error(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/activities").
+		ReplyError(errors.New("connection refused"))
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reviews.List(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Size: 30})
+	if err == nil {
+		t.Errorf("Expect transport error")
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Create(context.Background(), "", 0, &scm.ReviewInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"text": "typo in the heading",
+			"anchor": map[string]interface{}{
+				"path":     "README.md",
+				"line":     12,
+				"lineType": "ADDED",
+				"fileType": "TO",
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.Create(context.Background(), "PRJ/my-repo", 1, &scm.ReviewInput{Body: "typo in the heading", Path: "README.md", Line: 12})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_comment.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/1").
+		MatchParam("version", "0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.Delete(context.Background(), "PRJ/my-repo", 1, 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gock.IsPending() {
+		t.Errorf("Pending API calls")
 	}
 }
@@ -0,0 +1,142 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/internal/null"
+)
+
+type searchService struct {
+	client *wrapper
+}
+
+// Repositories returns a list of repositories matching the
+// search options. Bitbucket Server matches the organization
+// against the project name, and does not support filtering
+// by topic or language.
+func (s *searchService) Repositories(ctx context.Context, opts scm.RepositorySearchOptions) ([]*scm.Repository, *scm.Response, error) {
+	params := url.Values{}
+	if opts.Query != "" {
+		params.Set("name", opts.Query)
+	}
+	if opts.Org != "" {
+		params.Set("projectname", opts.Org)
+	}
+	if opts.Page > 1 {
+		params.Set("start", strconv.Itoa((opts.Page-1)*opts.Size))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := "rest/api/1.0/repos?" + params.Encode()
+	out := new(repositories)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertRepositoryList(out), res, err
+}
+
+// Code returns a list of files matching the search options
+// using the Bitbucket Server code search plugin.
+func (s *searchService) Code(ctx context.Context, opts scm.CodeSearchOptions) ([]*scm.CodeResult, *scm.Response, error) {
+	terms := []string{opts.Query}
+	if opts.Repo != "" {
+		terms = append(terms, "repo:"+opts.Repo)
+	}
+	if opts.Org != "" {
+		terms = append(terms, "project:"+opts.Org)
+	}
+	if opts.Path != "" {
+		terms = append(terms, "path:"+opts.Path)
+	}
+	in := new(searchInput)
+	in.Query = strings.TrimSpace(strings.Join(terms, " "))
+	in.Entities.Code.Limit = opts.Size
+	if opts.Page > 1 {
+		in.Entities.Code.Start = (opts.Page - 1) * opts.Size
+	}
+	out := new(searchResult)
+	res, err := s.client.do(ctx, "POST", "rest/search/latest/search", in, out)
+	if res != nil && !out.Code.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertCodeResultList(out.Code.Values), res, err
+}
+
+// Issues is not supported. Bitbucket Server does not
+// provide an issue tracker.
+func (s *searchService) Issues(ctx context.Context, opts scm.IssueSearchOptions) ([]*scm.Issue, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type searchInput struct {
+	Query    string `json:"query"`
+	Entities struct {
+		Code struct {
+			Start int `json:"start,omitempty"`
+			Limit int `json:"limit,omitempty"`
+		} `json:"code"`
+	} `json:"entities"`
+}
+
+type searchResult struct {
+	Code struct {
+		LastPage  null.Bool `json:"isLastPage"`
+		Count     int       `json:"count"`
+		Start     int       `json:"start"`
+		NextStart int       `json:"nextStart"`
+		Values    []*code   `json:"values"`
+	} `json:"code"`
+}
+
+type code struct {
+	Repository  *repository `json:"repository"`
+	File        string      `json:"file"`
+	HitContexts [][]struct {
+		Line int    `json:"line"`
+		Text string `json:"text"`
+	} `json:"hitContexts"`
+	HitCount int `json:"hitCount"`
+}
+
+func convertCodeResultList(from []*code) []*scm.CodeResult {
+	to := []*scm.CodeResult{}
+	for _, v := range from {
+		to = append(to, convertCodeResult(v))
+	}
+	return to
+}
+
+func convertCodeResult(from *code) *scm.CodeResult {
+	to := &scm.CodeResult{
+		Path: from.File,
+	}
+	if from.Repository != nil {
+		to.Repo = scm.Join(from.Repository.Project.Key, from.Repository.Slug)
+	}
+	for _, context := range from.HitContexts {
+		if len(context) == 0 {
+			continue
+		}
+		var lines []string
+		for _, line := range context {
+			lines = append(lines, line.Text)
+		}
+		to.Fragments = append(to.Fragments, scm.Fragment{
+			Line: context[0].Line,
+			Text: strings.Join(lines, "\n"),
+		})
+	}
+	return to
+}
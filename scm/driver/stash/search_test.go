@@ -0,0 +1,110 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/repos").
+		MatchParam("name", "my-repo").
+		MatchParam("projectname", "PRJ").
+		MatchParam("limit", "25").
+		MatchParam("start", "50").
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_repos.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Search.Repositories(context.Background(), scm.RepositorySearchOptions{
+		Query: "my-repo",
+		Org:   "PRJ",
+		Page:  3,
+		Size:  25,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Page.First, 1; got != want {
+		t.Errorf("Want Page.First %d, got %d", want, got)
+	}
+	if got, want := res.Page.Next, 4; got != want {
+		t.Errorf("Want Page.Next %d, got %d", want, got)
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/search_repos.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/search/latest/search").
+		JSON(map[string]interface{}{
+			"query": "main repo:PRJ/my-repo path:src",
+			"entities": map[string]interface{}{
+				"code": map[string]interface{}{
+					"limit": 25,
+				},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_code.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Search.Code(context.Background(), scm.CodeSearchOptions{
+		Query: "main",
+		Repo:  "PRJ/my-repo",
+		Path:  "src",
+		Page:  1,
+		Size:  25,
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want Page.Next %d, got %d", want, got)
+	}
+
+	want := []*scm.CodeResult{}
+	raw, _ := ioutil.ReadFile("testdata/search_code.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Search.Issues(context.Background(), scm.IssueSearchOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/url"
 	"strings"
 
@@ -44,6 +45,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Repositories = &repositoryService{client}
 	client.Releases = &releaseService{client}
 	client.Reviews = &reviewService{client}
+	client.Search = &searchService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -74,10 +76,32 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
-		buf := new(bytes.Buffer)
-		json.NewEncoder(buf).Encode(in)
-		req.Header["Content-Type"] = []string{"application/json"}
-		req.Body = buf
+		switch content := in.(type) {
+		case *contentCreateUpdate:
+			// file edits are submitted as a multipart form,
+			// with the file content in the content field.
+			buf := new(bytes.Buffer)
+			w := multipart.NewWriter(buf)
+			fw, _ := w.CreateFormField("content")
+			fw.Write(content.Content)
+			if content.Message != "" {
+				w.WriteField("message", content.Message)
+			}
+			if content.Branch != "" {
+				w.WriteField("branch", content.Branch)
+			}
+			if content.SourceCommitID != "" {
+				w.WriteField("sourceCommitId", content.SourceCommitID)
+			}
+			w.Close()
+			req.Header["Content-Type"] = []string{w.FormDataContentType()}
+			req.Body = buf
+		default:
+			buf := new(bytes.Buffer)
+			json.NewEncoder(buf).Encode(in)
+			req.Header["Content-Type"] = []string{"application/json"}
+			req.Body = buf
+		}
 	}
 
 	// execute the http request
@@ -113,6 +137,36 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+// stream sends a GET request for plain text and returns the
+// response body without reading it. The caller must close
+// the returned reader.
+func (c *wrapper) stream(ctx context.Context, path string, limit int64) (io.ReadCloser, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   path,
+		Header: map[string][]string{
+			"Accept": {"text/plain"},
+		},
+	}
+	res, err := c.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if an error is encountered, unmarshal and return the
+	// error response.
+	if res.Status == 401 {
+		res.Body.Close()
+		return nil, res, scm.ErrNotAuthorized
+	} else if res.Status > 300 {
+		defer res.Body.Close()
+		err := new(Error)
+		json.NewDecoder(res.Body).Decode(err)
+		return nil, res, err
+	}
+	return scm.LimitReadCloser(res.Body, limit), res, nil
+}
+
 // pagination represents Bitbucket pagination properties
 // embedded in list responses.
 type pagination struct {
@@ -10,7 +10,10 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -78,6 +81,76 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return email, res, err
 }
 
+// FindByEmail searches for the user account with a matching
+// email address.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*scm.User, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/users?filter=%s", url.QueryEscape(email))
+	out := new(userFilter)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, item := range out.Values {
+		// must be an exact match
+		if strings.EqualFold(item.EmailAddress, email) {
+			return convertUser(item), res, err
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// ListEmails is not supported. Bitbucket Server user
+// accounts have a single email address, which is returned
+// by FindEmail.
+func (s *userService) ListEmails(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *userService) ListKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.Key, *scm.Response, error) {
+	path := fmt.Sprintf("rest/ssh/1.0/keys?%s", encodeListOptions(opts))
+	out := new(keys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertKeyList(out), res, err
+}
+
+func (s *userService) CreateKey(ctx context.Context, input *scm.KeyInput) (*scm.Key, *scm.Response, error) {
+	in := &keyInput{
+		Text:  input.Key,
+		Label: input.Title,
+	}
+	out := new(key)
+	res, err := s.client.do(ctx, "POST", "rest/ssh/1.0/keys", in, out)
+	return convertKey(out), res, err
+}
+
+func (s *userService) ListGPGKeys(ctx context.Context, opts scm.ListOptions) ([]*scm.GPGKey, *scm.Response, error) {
+	path := fmt.Sprintf("rest/gpg/1.0/keys?%s", encodeListOptions(opts))
+	out := new(gpgKeys)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertGPGKeyList(out), res, err
+}
+
+func (s *userService) CreateGPGKey(ctx context.Context, input *scm.GPGKeyInput) (*scm.GPGKey, *scm.Response, error) {
+	in := &gpgKeyInput{
+		Text: input.Key,
+	}
+	out := new(gpgKey)
+	res, err := s.client.do(ctx, "POST", "rest/gpg/1.0/keys", in, out)
+	return convertGPGKey(out), res, err
+}
+
+// ListRepositories returns the personal repositories of the
+// user account by user slug.
+func (s *userService) ListRepositories(ctx context.Context, login string, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := fmt.Sprintf("rest/api/1.0/users/%s/repos?%s", login, encodeListOptions(opts))
+	out := new(repositories)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	copyPagination(out.pagination, res)
+	return convertRepositoryList(out), res, err
+}
+
 type user struct {
 	Name         string `json:"name"`
 	EmailAddress string `json:"emailAddress"`
@@ -97,13 +170,93 @@ type userFilter struct {
 	Values []*user `json:"values"`
 }
 
+type keys struct {
+	pagination
+	Values []*key `json:"values"`
+}
+
+type key struct {
+	ID    int    `json:"id"`
+	Text  string `json:"text"`
+	Label string `json:"label"`
+}
+
+type keyInput struct {
+	Text  string `json:"text"`
+	Label string `json:"label,omitempty"`
+}
+
+type gpgKeys struct {
+	pagination
+	Values []*gpgKey `json:"values"`
+}
+
+type gpgKey struct {
+	ID           string `json:"id"`
+	Fingerprint  string `json:"fingerprint"`
+	EmailAddress string `json:"emailAddress"`
+	ExpiryDate   int64  `json:"expiryDate"`
+	Text         string `json:"text"`
+}
+
+type gpgKeyInput struct {
+	Text string `json:"text"`
+}
+
 func convertUser(from *user) *scm.User {
-	return &scm.User{
+	to := &scm.User{
+		ID:     strconv.Itoa(from.ID),
 		Avatar: avatarLink(from.EmailAddress),
 		Login:  from.Slug,
 		Name:   from.DisplayName,
 		Email:  from.EmailAddress,
+		Type:   scm.UserTypeUser,
+	}
+	if len(from.Links.Self) != 0 {
+		to.Link = from.Links.Self[0].Href
+	}
+	if from.Type == "SERVICE" {
+		to.Type = scm.UserTypeBot
+	}
+	return to
+}
+
+func convertKeyList(from *keys) []*scm.Key {
+	to := []*scm.Key{}
+	for _, v := range from.Values {
+		to = append(to, convertKey(v))
+	}
+	return to
+}
+
+func convertKey(from *key) *scm.Key {
+	return &scm.Key{
+		ID:    from.ID,
+		Title: from.Label,
+		Key:   from.Text,
+	}
+}
+
+func convertGPGKeyList(from *gpgKeys) []*scm.GPGKey {
+	to := []*scm.GPGKey{}
+	for _, v := range from.Values {
+		to = append(to, convertGPGKey(v))
+	}
+	return to
+}
+
+func convertGPGKey(from *gpgKey) *scm.GPGKey {
+	to := &scm.GPGKey{
+		KeyID: from.ID,
+		Key:   from.Text,
+	}
+	if from.EmailAddress != "" {
+		to.Emails = []string{from.EmailAddress}
+	}
+	if from.ExpiryDate != 0 {
+		to.Expires = time.Unix(from.ExpiryDate/1000, 0)
 	}
+	return to
 }
 
 func avatarLink(email string) string {
@@ -179,3 +179,207 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Want email %s, got %s", want, got)
 	}
 }
+
+func TestUserFindByEmail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/users").
+		MatchParam("filter", "jane@example.com").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_search.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.FindByEmail(context.Background(), "jane@example.com")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.User)
+	raw, _ := ioutil.ReadFile("testdata/user_search.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserFindByEmail_NoMatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/users").
+		MatchParam("filter", "jane@example.org").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_search.json")
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Users.FindByEmail(context.Background(), "jane@example.org")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want Not Found error, got %v", err)
+	}
+}
+
+func TestUserListEmails(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Users.ListEmails(context.Background(), scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestUserListKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/ssh/1.0/keys").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.ListKeys(context.Background(), scm.ListOptions{Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Key{}
+	raw, _ := ioutil.ReadFile("testdata/user_keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserCreateKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/ssh/1.0/keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"text":  "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC0m6IUhNkp8P+R4nCLZnYHc2J0DyQcLL8E1fdRlHzzx7Xrp9PL0Z4ISO8oBIuAQ1UaqsfxIEQT7gJHFUsclDYHVZoPmqT8zAsCW6WDKFkMgi8GA4g1T2DnA38e0Ja6V5cg9+DjDyh2PEB2c8iCwyNOuLP8q7s5/21ZhNVz0S+OdUdavHgWpE0+NbgiqFQUBnD+tHDOw7IaK7j5HTcPw3zlKTH6vb5h2kdNMv3qI6kTaWlLb6F6zfGJ4PF23hhSwfqBeU8s8Vkfer7ZrYlgVOUTbSD2WB4TUz1YLkUjwJIEN3m1vzALGSWsAOKP6ZwpJxTRwpeEYCiCsOcdsE0DU3qh jane@example.com",
+			"label": "jane@example.com",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_key.json")
+
+	input := &scm.KeyInput{
+		Title: "jane@example.com",
+		Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC0m6IUhNkp8P+R4nCLZnYHc2J0DyQcLL8E1fdRlHzzx7Xrp9PL0Z4ISO8oBIuAQ1UaqsfxIEQT7gJHFUsclDYHVZoPmqT8zAsCW6WDKFkMgi8GA4g1T2DnA38e0Ja6V5cg9+DjDyh2PEB2c8iCwyNOuLP8q7s5/21ZhNVz0S+OdUdavHgWpE0+NbgiqFQUBnD+tHDOw7IaK7j5HTcPw3zlKTH6vb5h2kdNMv3qI6kTaWlLb6F6zfGJ4PF23hhSwfqBeU8s8Vkfer7ZrYlgVOUTbSD2WB4TUz1YLkUjwJIEN3m1vzALGSWsAOKP6ZwpJxTRwpeEYCiCsOcdsE0DU3qh jane@example.com",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.CreateKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Key)
+	raw, _ := ioutil.ReadFile("testdata/user_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListGPGKeys(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/gpg/1.0/keys").
+		MatchParam("limit", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_gpg_keys.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.ListGPGKeys(context.Background(), scm.ListOptions{Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.GPGKey{}
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_keys.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserCreateGPGKey(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/gpg/1.0/keys").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"text": "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/user_gpg_key.json")
+
+	input := &scm.GPGKeyInput{
+		Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.CreateGPGKey(context.Background(), input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.GPGKey)
+	raw, _ := ioutil.ReadFile("testdata/user_gpg_key.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserListRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/users/jcitizen/repos").
+		MatchParam("limit", "25").
+		MatchParam("start", "50").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repos.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Users.ListRepositories(context.Background(), "jcitizen", scm.ListOptions{Page: 3, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/repos.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
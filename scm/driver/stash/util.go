@@ -39,6 +39,27 @@ func encodeListRoleOptions(opts scm.ListOptions) string {
 	return params.Encode()
 }
 
+func encodeCommitListOptions(opts scm.CommitListOptions) string {
+	params := url.Values{}
+	if opts.Page > 1 {
+		params.Set("start", strconv.Itoa(
+			(opts.Page-1)*opts.Size),
+		)
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	// bitbucket server lists the commits reachable from
+	// the until parameter, which accepts a ref or sha.
+	if opts.Ref != "" {
+		params.Set("until", opts.Ref)
+	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	return params.Encode()
+}
+
 func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	params := url.Values{}
 	if opts.Page > 1 {
@@ -51,9 +72,27 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	}
 	if opts.Open && opts.Closed {
 		params.Set("state", "all")
+	} else if opts.Merged {
+		params.Set("state", "merged")
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	// bitbucket server filters pull requests by a single
+	// branch, where the direction indicates whether the
+	// branch is the source or the target.
+	if opts.Target != "" {
+		params.Set("at", scm.ExpandRef(opts.Target, "refs/heads"))
+		params.Set("direction", "incoming")
+	} else if opts.Source != "" {
+		params.Set("at", scm.ExpandRef(opts.Source, "refs/heads"))
+		params.Set("direction", "outgoing")
+	}
+	switch opts.Direction {
+	case scm.SortAsc:
+		params.Set("order", "oldest")
+	case scm.SortDesc:
+		params.Set("order", "newest")
+	}
 	return params.Encode()
 }
 
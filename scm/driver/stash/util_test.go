@@ -32,6 +32,20 @@ func Test_encodeListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Page: 10,
+		Size: 30,
+		Ref:  "master",
+		Path: "docs",
+	}
+	want := "limit=30&path=docs&start=270&until=master"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
@@ -46,3 +60,18 @@ func Test_encodePullRequestListOptions(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_encodePullRequestListOptions_Filter(t *testing.T) {
+	t.Parallel()
+	opts := scm.PullRequestListOptions{
+		Merged:    true,
+		Source:    "feature/x",
+		Target:    "master",
+		Direction: scm.SortAsc,
+	}
+	want := "at=refs%2Fheads%2Fmaster&direction=incoming&order=oldest&state=merged"
+	got := encodePullRequestListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded pr list options %q, got %q", want, got)
+	}
+}
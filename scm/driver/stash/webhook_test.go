@@ -37,6 +37,15 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/push.json.golden",
 			obj:    new(scm.PushHook),
 		},
+		// project hooks deliver the same payload as
+		// repository hooks.
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "repo:refs_changed",
+			before: "testdata/webhooks/push_project.json",
+			after:  "testdata/webhooks/push_project.json.golden",
+			obj:    new(scm.PushHook),
+		},
 
 		//
 		// tag events
@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -36,11 +37,26 @@ type (
 	}
 
 	// CommitListOptions provides options for querying a
-	// list of repository commits.
+	// list of repository commits. Filters not supported
+	// natively by the provider are applied by the driver
+	// to each page of results.
 	CommitListOptions struct {
 		Ref  string
 		Page int
 		Size int
+
+		// Path limits the results to commits that
+		// modify the file or directory.
+		Path string
+
+		// Author limits the results to commits by
+		// the author login, name or email address.
+		Author string
+
+		// Since and Until limit the results to commits
+		// authored within the time range.
+		Since time.Time
+		Until time.Time
 	}
 
 	// Signature identifies a git commit creator.
@@ -86,5 +102,13 @@ type (
 		// of the target commit, it is up to the driver to
 		// return a 2-way or 3-way diff changeset.
 		CompareChanges(ctx context.Context, repo, source, target string, opts ListOptions) ([]*Change, *Response, error)
+
+		// Diff returns the raw diff or patch of a commit.
+		// The caller must close the reader.
+		Diff(ctx context.Context, repo, ref string, opts DiffOptions) (io.ReadCloser, *Response, error)
+
+		// CompareDiff returns the raw diff or patch between
+		// two commits. The caller must close the reader.
+		CompareDiff(ctx context.Context, repo, source, target string, opts DiffOptions) (io.ReadCloser, *Response, error)
 	}
 )
@@ -6,6 +6,14 @@ package scm
 
 import "context"
 
+// LineRange identifies a range of lines in a file. A zero
+// Start selects no lines, and a zero End selects the Start
+// line only.
+type LineRange struct {
+	Start int
+	End   int
+}
+
 // Linker provides deep links to resources.
 type Linker interface {
 	// Resource returns a link to the resource.
@@ -13,4 +21,25 @@ type Linker interface {
 
 	// Diff returns a link to the diff.
 	Diff(ctx context.Context, repo string, source, target Reference) (string, error)
+
+	// File returns a link to the file at the reference,
+	// highlighting the optional range of lines.
+	File(ctx context.Context, repo string, ref Reference, path string, lines LineRange) (string, error)
+
+	// Blame returns a link to the blame view of the file
+	// at the reference.
+	Blame(ctx context.Context, repo string, ref Reference, path string) (string, error)
+
+	// Issue returns a link to the issue.
+	Issue(ctx context.Context, repo string, number int) (string, error)
+
+	// PullRequestComment returns a link to the pull
+	// request comment.
+	PullRequestComment(ctx context.Context, repo string, number, id int) (string, error)
+
+	// Release returns a link to the release by tag.
+	Release(ctx context.Context, repo, tag string) (string, error)
+
+	// User returns a link to the user profile.
+	User(ctx context.Context, login string) (string, error)
 }
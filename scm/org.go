@@ -21,6 +21,21 @@ type (
 		Role   Role
 	}
 
+	// Member represents an organization or team member
+	// and the role granted to the member.
+	Member struct {
+		User User
+		Role Role
+	}
+
+	// Team represents an organization team.
+	Team struct {
+		ID          int
+		Name        string
+		Slug        string
+		Description string
+	}
+
 	// OrganizationService provides access to organization resources.
 	OrganizationService interface {
 		// Find returns the organization by name.
@@ -32,5 +47,44 @@ type (
 
 		// List returns the user organization list.
 		List(ctx context.Context, opts ListOptions) ([]*Organization, *Response, error)
+
+		// ListMembers returns the organization members and
+		// their roles.
+		ListMembers(ctx context.Context, name string, opts ListOptions) ([]*Member, *Response, error)
+
+		// ListTeams returns the organization teams.
+		ListTeams(ctx context.Context, name string, opts ListOptions) ([]*Team, *Response, error)
+
+		// ListTeamMembers returns the members of an organization
+		// team. The team is identified by its slug, or by its id
+		// if the provider does not use slugs.
+		ListTeamMembers(ctx context.Context, name, team string, opts ListOptions) ([]*Member, *Response, error)
+
+		// ListRepositories returns the organization repositories.
+		ListRepositories(ctx context.Context, name string, opts ListOptions) ([]*Repository, *Response, error)
+
+		// AddMember adds the user account to the organization
+		// with the given role.
+		AddMember(ctx context.Context, name, username string, role Role) (*Membership, *Response, error)
+
+		// RemoveMember removes the user account from the
+		// organization.
+		RemoveMember(ctx context.Context, name, username string) (*Response, error)
+
+		// FindHook returns an organization hook.
+		FindHook(ctx context.Context, name, id string) (*Hook, *Response, error)
+
+		// ListHooks returns the organization hooks.
+		ListHooks(ctx context.Context, name string, opts ListOptions) ([]*Hook, *Response, error)
+
+		// CreateHook creates an organization hook that
+		// receives events for all organization repositories.
+		CreateHook(ctx context.Context, name string, input *HookInput) (*Hook, *Response, error)
+
+		// UpdateHook updates an organization hook.
+		UpdateHook(ctx context.Context, name, id string, input *HookInput) (*Hook, *Response, error)
+
+		// DeleteHook deletes an organization hook.
+		DeleteHook(ctx context.Context, name, id string) (*Response, error)
 	}
 )
@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -41,12 +42,36 @@ type (
 	}
 
 	// PullRequestListOptions provides options for querying
-	// a list of repository merge requests.
+	// a list of repository merge requests. Filters that are
+	// not supported natively by the provider are applied by
+	// the driver to each page of results.
 	PullRequestListOptions struct {
 		Page   int
 		Size   int
 		Open   bool
 		Closed bool
+
+		// Merged restricts the list to merged pull requests.
+		Merged bool
+
+		// Source and Target filter the list by the name of
+		// the source and target branches.
+		Source string
+		Target string
+
+		// Author filters the list by the author login.
+		Author string
+
+		// Labels filters the list to pull requests that
+		// have all of the named labels.
+		Labels []string
+
+		// Since filters the list to pull requests updated
+		// at or after the given time.
+		Since time.Time
+
+		Sort      SortBy
+		Direction SortDirection
 	}
 
 	// Change represents a changed file.
@@ -57,6 +82,26 @@ type (
 		Deleted bool
 		Sha     string
 		BlobID  string
+
+		// PrevPath is the path of the file before it
+		// was renamed.
+		PrevPath string
+
+		// Patch is the unified diff of the file, if
+		// provided. Use ParsePatch to extract the hunks.
+		Patch string
+
+		// Additions and Deletions are the number of
+		// added and deleted lines, if provided.
+		Additions int
+		Deletions int
+
+		// Binary is true if the file is binary.
+		Binary bool
+
+		// Mode is the git file mode of the file after
+		// the change (e.g. 100644), if provided.
+		Mode string
 	}
 
 	Label struct {
@@ -89,6 +134,10 @@ type (
 		// ListChanges returns the pull request changeset.
 		ListChanges(context.Context, string, int, ListOptions) ([]*Change, *Response, error)
 
+		// Diff returns the raw diff or patch of the pull
+		// request. The caller must close the reader.
+		Diff(context.Context, string, int, DiffOptions) (io.ReadCloser, *Response, error)
+
 		// ListComments returns the pull request comment list.
 		ListComments(context.Context, string, int, ListOptions) ([]*Comment, *Response, error)
 
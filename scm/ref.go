@@ -0,0 +1,173 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RefKind identifies the kind of a git reference.
+type RefKind int
+
+// RefKind values.
+const (
+	RefKindOther RefKind = iota
+	RefKindBranch
+	RefKindTag
+	RefKindPullRequestHead
+	RefKindPullRequestMerge
+	RefKindNote
+)
+
+// String returns the string representation of RefKind.
+func (k RefKind) String() string {
+	switch k {
+	case RefKindBranch:
+		return "branch"
+	case RefKindTag:
+		return "tag"
+	case RefKindPullRequestHead:
+		return "pull_request_head"
+	case RefKindPullRequestMerge:
+		return "pull_request_merge"
+	case RefKindNote:
+		return "note"
+	default:
+		return "other"
+	}
+}
+
+// Ref represents a parsed git reference.
+type Ref struct {
+	Kind RefKind
+
+	// Name is the short name of a branch, tag or note
+	// (e.g. master), or the full reference path of any
+	// other reference. Name is empty for pull requests.
+	Name string
+
+	// Number is the pull request number.
+	Number int
+
+	// Path is the full reference path (e.g. refs/heads/master).
+	Path string
+}
+
+// IsPullRequest returns true if the reference points to the
+// head or merge commit of a pull request.
+func (r Ref) IsPullRequest() bool {
+	return r.Kind == RefKindPullRequestHead ||
+		r.Kind == RefKindPullRequestMerge
+}
+
+// pullRef describes the pull request reference layout of
+// a driver (e.g. refs/pull/{number}/head).
+type pullRef struct {
+	prefix string
+	head   string
+	merge  string
+}
+
+// pullRefs maps each driver to the pull request reference
+// layout. An empty head or merge suffix indicates the
+// reference is not provided by the driver.
+var pullRefs = map[Driver]pullRef{
+	DriverGithub:    {prefix: "refs/pull/", head: "head", merge: "merge"},
+	DriverGitea:     {prefix: "refs/pull/", head: "head"},
+	DriverGogs:      {prefix: "refs/pull/", head: "head"},
+	DriverGitee:     {prefix: "refs/pull/", head: "head", merge: "MERGE"},
+	DriverGitlab:    {prefix: "refs/merge-requests/", head: "head", merge: "merge"},
+	DriverStash:     {prefix: "refs/pull-requests/", head: "from", merge: "merge"},
+	DriverBitbucket: {},
+}
+
+// legacy pull request reference layouts that are not tied
+// to a driver.
+var legacyPullRefs = []pullRef{
+	{prefix: "refs/pull-request/", head: "head", merge: "merge"},
+}
+
+// ParseRef parses the git reference path. Pull request
+// references of all drivers are recognized. Use
+// ParseDriverRef to only recognize the pull request
+// references of a single driver.
+func ParseRef(ref string) Ref {
+	layouts := append([]pullRef(nil), legacyPullRefs...)
+	for _, driver := range []Driver{
+		DriverGithub,
+		DriverGitee,
+		DriverGitlab,
+		DriverStash,
+	} {
+		layouts = append(layouts, pullRefs[driver])
+	}
+	return parseRef(ref, layouts)
+}
+
+// ParseDriverRef parses the git reference path, recognizing
+// only the pull request references of the driver (e.g.
+// refs/merge-requests/{number}/head for GitLab).
+func ParseDriverRef(driver Driver, ref string) Ref {
+	layout, ok := pullRefs[driver]
+	if !ok {
+		return ParseRef(ref)
+	}
+	return parseRef(ref, []pullRef{layout})
+}
+
+func parseRef(ref string, layouts []pullRef) Ref {
+	switch {
+	case strings.HasPrefix(ref, "refs/heads/"):
+		return Ref{Kind: RefKindBranch, Name: ref[len("refs/heads/"):], Path: ref}
+	case strings.HasPrefix(ref, "refs/tags/"):
+		return Ref{Kind: RefKindTag, Name: ref[len("refs/tags/"):], Path: ref}
+	case strings.HasPrefix(ref, "refs/notes/"):
+		return Ref{Kind: RefKindNote, Name: ref[len("refs/notes/"):], Path: ref}
+	}
+	for _, layout := range layouts {
+		if layout.prefix == "" || !strings.HasPrefix(ref, layout.prefix) {
+			continue
+		}
+		parts := strings.Split(ref[len(layout.prefix):], "/")
+		if len(parts) != 2 {
+			continue
+		}
+		number, err := strconv.Atoi(parts[0])
+		if err != nil || number <= 0 {
+			continue
+		}
+		switch {
+		case layout.head != "" && parts[1] == layout.head:
+			return Ref{Kind: RefKindPullRequestHead, Number: number, Path: ref}
+		case layout.merge != "" && parts[1] == layout.merge:
+			return Ref{Kind: RefKindPullRequestMerge, Number: number, Path: ref}
+		}
+	}
+	return Ref{Kind: RefKindOther, Name: ref, Path: ref}
+}
+
+// PullRequestHeadRef returns the reference path of the pull
+// request head commit for the driver, or an empty string if
+// the driver does not provide the reference.
+func PullRequestHeadRef(driver Driver, number int) string {
+	layout := pullRefs[driver]
+	if layout.head == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%d/%s", layout.prefix, number, layout.head)
+}
+
+// PullRequestMergeRef returns the reference path of the pull
+// request merge commit for the driver, or an empty string if
+// the driver does not provide the reference.
+func PullRequestMergeRef(driver Driver, number int) string {
+	layout := pullRefs[driver]
+	if layout.merge == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%d/%s", layout.prefix, number, layout.merge)
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "testing"
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want Ref
+	}{
+		{"refs/heads/master", Ref{Kind: RefKindBranch, Name: "master"}},
+		{"refs/heads/release-2/fix", Ref{Kind: RefKindBranch, Name: "release-2/fix"}},
+		{"refs/tags/v1.0.0", Ref{Kind: RefKindTag, Name: "v1.0.0"}},
+		{"refs/notes/commits", Ref{Kind: RefKindNote, Name: "commits"}},
+		{"refs/pull/12/head", Ref{Kind: RefKindPullRequestHead, Number: 12}},
+		{"refs/pull/12/merge", Ref{Kind: RefKindPullRequestMerge, Number: 12}},
+		{"refs/pull/12/MERGE", Ref{Kind: RefKindPullRequestMerge, Number: 12}},
+		{"refs/merge-requests/12/head", Ref{Kind: RefKindPullRequestHead, Number: 12}},
+		{"refs/merge-requests/12/merge", Ref{Kind: RefKindPullRequestMerge, Number: 12}},
+		{"refs/pull-requests/12/from", Ref{Kind: RefKindPullRequestHead, Number: 12}},
+		{"refs/pull-requests/12/merge", Ref{Kind: RefKindPullRequestMerge, Number: 12}},
+		{"refs/pull/12/foo", Ref{Kind: RefKindOther, Name: "refs/pull/12/foo"}},
+		{"refs/pull/foo/head", Ref{Kind: RefKindOther, Name: "refs/pull/foo/head"}},
+		{"refs/remotes/origin/master", Ref{Kind: RefKindOther, Name: "refs/remotes/origin/master"}},
+		{"master", Ref{Kind: RefKindOther, Name: "master"}},
+	}
+	for _, test := range tests {
+		want := test.want
+		want.Path = test.ref
+		if got := ParseRef(test.ref); got != want {
+			t.Errorf("Want ref %+v for %s, got %+v", want, test.ref, got)
+		}
+	}
+}
+
+func TestParseDriverRef(t *testing.T) {
+	tests := []struct {
+		driver Driver
+		ref    string
+		kind   RefKind
+	}{
+		{DriverGitlab, "refs/merge-requests/12/head", RefKindPullRequestHead},
+		{DriverGitlab, "refs/pull/12/head", RefKindOther},
+		{DriverStash, "refs/pull-requests/12/from", RefKindPullRequestHead},
+		{DriverStash, "refs/pull-requests/12/head", RefKindOther},
+		{DriverGitee, "refs/pull/12/MERGE", RefKindPullRequestMerge},
+		{DriverGithub, "refs/pull/12/MERGE", RefKindOther},
+		{DriverBitbucket, "refs/pull/12/head", RefKindOther},
+		{DriverBitbucket, "refs/heads/master", RefKindBranch},
+	}
+	for _, test := range tests {
+		if got, want := ParseDriverRef(test.driver, test.ref).Kind, test.kind; got != want {
+			t.Errorf("Want kind %s for %s ref %s, got %s", want, test.driver, test.ref, got)
+		}
+	}
+}
+
+func TestPullRequestRefs(t *testing.T) {
+	tests := []struct {
+		driver      Driver
+		head, merge string
+	}{
+		{DriverGithub, "refs/pull/12/head", "refs/pull/12/merge"},
+		{DriverGitea, "refs/pull/12/head", ""},
+		{DriverGogs, "refs/pull/12/head", ""},
+		{DriverGitee, "refs/pull/12/head", "refs/pull/12/MERGE"},
+		{DriverGitlab, "refs/merge-requests/12/head", "refs/merge-requests/12/merge"},
+		{DriverStash, "refs/pull-requests/12/from", "refs/pull-requests/12/merge"},
+		{DriverBitbucket, "", ""},
+	}
+	for _, test := range tests {
+		if got, want := PullRequestHeadRef(test.driver, 12), test.head; got != want {
+			t.Errorf("Want %s head ref %q, got %q", test.driver, want, got)
+		}
+		if got, want := PullRequestMergeRef(test.driver, 12), test.merge; got != want {
+			t.Errorf("Want %s merge ref %q, got %q", test.driver, want, got)
+		}
+		if test.head == "" {
+			continue
+		}
+		ref := ParseDriverRef(test.driver, test.head)
+		if ref.Kind != RefKindPullRequestHead || ref.Number != 12 {
+			t.Errorf("Want %s head ref %s to round trip, got %+v", test.driver, test.head, ref)
+		}
+	}
+}
@@ -12,9 +12,15 @@ import (
 type (
 	// Repository represents a git repository.
 	Repository struct {
-		ID         string
-		Namespace  string
-		Name       string
+		ID string
+
+		// Namespace is the full namespace path of the
+		// repository, which may contain multiple segments
+		// for providers that support nested namespaces
+		// (e.g. GitLab subgroups).
+		Namespace string
+		Name      string
+
 		Perm       *Perm
 		Branch     string
 		Private    bool
@@ -24,6 +30,22 @@ type (
 		Link       string
 		Created    time.Time
 		Updated    time.Time
+
+		Description string
+		Topics      []string
+		Language    string
+		Archived    bool
+		Stars       int
+		OpenIssues  int
+
+		// Size is the repository size in kilobytes.
+		Size int64
+
+		// Fork is true if the repository is a fork, in
+		// which case Parent is the full name of the parent
+		// repository, if known.
+		Fork   bool
+		Parent string
 	}
 
 	// Perm represents a user's repository permissions.
@@ -64,11 +86,34 @@ type (
 		Deployment         bool
 		Issue              bool
 		IssueComment       bool
+		Member             bool
+		Pipeline           bool // pipeline, workflow and job events
 		PullRequest        bool
 		PullRequestComment bool
+		PullRequestReview  bool
 		Push               bool
+		Release            bool
+		Repository         bool
 		ReviewComment      bool
+		Status             bool // commit status and check events
 		Tag                bool
+		Wiki               bool
+	}
+
+	// HookDelivery represents a webhook delivery attempt.
+	HookDelivery struct {
+		ID       string
+		Event    string
+		Status   int
+		Duration time.Duration
+		Created  time.Time
+
+		// Request and Response are the request payload and
+		// response body. They are empty if the provider only
+		// returns them when finding a single delivery, or
+		// does not record them at all.
+		Request  string
+		Response string
 	}
 
 	// Status represents a commit status.
@@ -105,6 +150,21 @@ type (
 		EnvironmentURL string
 	}
 
+	// Language represents a language detected in the
+	// repository source code.
+	Language struct {
+		Name string
+
+		// Bytes is the amount of code written in the
+		// language. It is zero if the provider only
+		// reports percentages.
+		Bytes int64
+
+		// Percent is the share of the repository code
+		// written in the language.
+		Percent float64
+	}
+
 	// RepositoryService provides access to repository resources.
 	RepositoryService interface {
 		// Find returns a repository by name.
@@ -125,6 +185,17 @@ type (
 		// ListStatus returns a list of commit statuses.
 		ListStatus(context.Context, string, string, ListOptions) ([]*Status, *Response, error)
 
+		// ListTopics returns the repository topics.
+		ListTopics(context.Context, string) ([]string, *Response, error)
+
+		// ListLanguages returns the repository languages,
+		// ordered by share of the code base.
+		ListLanguages(context.Context, string) ([]*Language, *Response, error)
+
+		// ReplaceTopics replaces all repository topics and
+		// returns the updated list.
+		ReplaceTopics(context.Context, string, []string) ([]string, *Response, error)
+
 		// CreateHook creates a new repository hook.
 		CreateHook(context.Context, string, *HookInput) (*Hook, *Response, error)
 
@@ -136,6 +207,19 @@ type (
 
 		// DeleteHook deletes a repository hook.
 		DeleteHook(context.Context, string, string) (*Response, error)
+
+		// ListHookDeliveries returns the recent deliveries
+		// of a repository hook.
+		ListHookDeliveries(context.Context, string, string, ListOptions) ([]*HookDelivery, *Response, error)
+
+		// FindHookDelivery returns a repository hook delivery.
+		FindHookDelivery(context.Context, string, string, string) (*HookDelivery, *Response, error)
+
+		// RedeliverHook redelivers a repository hook delivery.
+		RedeliverHook(context.Context, string, string, string) (*Response, error)
+
+		// PingHook sends a test event to a repository hook.
+		PingHook(context.Context, string, string) (*Response, error)
 	}
 )
 
@@ -0,0 +1,188 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownURL is returned when a url cannot be parsed
+// into a resource.
+var ErrUnknownURL = errors.New("Unknown resource url")
+
+// ResourceKind identifies the kind of resource referenced
+// by a url.
+type ResourceKind int
+
+// ResourceKind values.
+const (
+	ResourceKindUnknown ResourceKind = iota
+	ResourceKindRepository
+	ResourceKindPullRequest
+	ResourceKindIssue
+	ResourceKindCommit
+	ResourceKindTree
+	ResourceKindBlob
+	ResourceKindTag
+	ResourceKindRelease
+)
+
+// String returns the string representation of ResourceKind.
+func (k ResourceKind) String() string {
+	switch k {
+	case ResourceKindRepository:
+		return "repository"
+	case ResourceKindPullRequest:
+		return "pull_request"
+	case ResourceKindIssue:
+		return "issue"
+	case ResourceKindCommit:
+		return "commit"
+	case ResourceKindTree:
+		return "tree"
+	case ResourceKindBlob:
+		return "blob"
+	case ResourceKindTag:
+		return "tag"
+	case ResourceKindRelease:
+		return "release"
+	default:
+		return "unknown"
+	}
+}
+
+// Resource represents a resource referenced by a web or
+// api url.
+type Resource struct {
+	Driver Driver
+	Host   string
+	Repo   string
+	Kind   ResourceKind
+
+	// Number is the pull request or issue number.
+	Number int
+
+	// Sha is the commit sha.
+	Sha string
+
+	// Ref is the branch or tag name, or the commit sha,
+	// of a tree or blob. Ref is the tag name of a tag or
+	// release. Refs that contain a slash cannot always be
+	// distinguished from the path, in which case the
+	// first path segment is used.
+	Ref string
+
+	// Path and Lines identify the file or directory, and
+	// the highlighted range of lines, of a tree or blob.
+	Path  string
+	Lines LineRange
+}
+
+// URLParser parses a web or api url into a resource.
+type URLParser func(rawurl string) (*Resource, error)
+
+var (
+	parsersMu sync.RWMutex
+	parsers   = map[Driver]URLParser{}
+)
+
+// knownHosts maps the hosts of the cloud providers to the
+// driver, which is tried exclusively for urls on the host.
+var knownHosts = map[string]Driver{
+	"github.com":        DriverGithub,
+	"api.github.com":    DriverGithub,
+	"gitlab.com":        DriverGitlab,
+	"bitbucket.org":     DriverBitbucket,
+	"api.bitbucket.org": DriverBitbucket,
+	"gitee.com":         DriverGitee,
+}
+
+// RegisterURLParser registers the url parser for the
+// driver. Drivers register their parser when the driver
+// package is imported.
+func RegisterURLParser(driver Driver, parser URLParser) {
+	parsersMu.Lock()
+	parsers[driver] = parser
+	parsersMu.Unlock()
+}
+
+// ParseURL parses a web or api url into a resource using
+// the registered url parsers. Urls of the cloud providers
+// are parsed by the provider driver. Other urls are parsed
+// by every driver and the most specific match is returned,
+// preferring a pull request, issue, commit or file over a
+// repository, and a repository with the fewest path
+// segments (e.g. a GitLab subgroup path matches any url).
+// Self-hosted providers that share the same url layout
+// (e.g. Gitea and Gogs) cannot always be distinguished, in
+// which case the first match in driver order is returned.
+func ParseURL(rawurl string) (*Resource, error) {
+	parsersMu.RLock()
+	drivers := make([]Driver, 0, len(parsers))
+	for driver := range parsers {
+		drivers = append(drivers, driver)
+	}
+	if u, err := url.Parse(rawurl); err == nil {
+		if driver, ok := knownHosts[u.Hostname()]; ok && parsers[driver] != nil {
+			drivers = []Driver{driver}
+		}
+	}
+	sort.Slice(drivers, func(i, j int) bool {
+		return drivers[i] < drivers[j]
+	})
+	var list []URLParser
+	for _, driver := range drivers {
+		list = append(list, parsers[driver])
+	}
+	parsersMu.RUnlock()
+
+	var match *Resource
+	for _, parse := range list {
+		res, err := parse(rawurl)
+		if err != nil {
+			continue
+		}
+		if res.Kind != ResourceKindRepository {
+			return res, nil
+		}
+		if match == nil || strings.Count(res.Repo, "/") < strings.Count(match.Repo, "/") {
+			match = res
+		}
+	}
+	if match == nil {
+		return nil, ErrUnknownURL
+	}
+	return match, nil
+}
+
+// ParseLineRange parses the url fragment that highlights a
+// range of lines in a file (e.g. L10-L20, L10-20, lines-10:20
+// or 10-20).
+func ParseLineRange(fragment string) LineRange {
+	fragment = strings.TrimPrefix(fragment, "#")
+	fragment = strings.TrimPrefix(fragment, "lines-")
+	parts := strings.FieldsFunc(fragment, func(r rune) bool {
+		return r == '-' || r == ':'
+	})
+	var lines LineRange
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimPrefix(part, "L"))
+		if err != nil {
+			return LineRange{}
+		}
+		switch i {
+		case 0:
+			lines.Start = n
+		case 1:
+			lines.End = n
+		}
+	}
+	return lines
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseLineRange(t *testing.T) {
+	tests := []struct {
+		fragment string
+		want     LineRange
+	}{
+		{"", LineRange{}},
+		{"#L10", LineRange{Start: 10}},
+		{"L10-L20", LineRange{Start: 10, End: 20}},
+		{"L10-20", LineRange{Start: 10, End: 20}},
+		{"lines-10:20", LineRange{Start: 10, End: 20}},
+		{"10-20", LineRange{Start: 10, End: 20}},
+		{"readme", LineRange{}},
+	}
+	for _, test := range tests {
+		if got, want := ParseLineRange(test.fragment), test.want; got != want {
+			t.Errorf("Want line range %v for %q, got %v", want, test.fragment, got)
+		}
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	parsersMu.Lock()
+	saved := parsers
+	parsers = map[Driver]URLParser{
+		// parses any url as a repository with the full path
+		// as the repository name, similar to gitlab subgroups.
+		DriverGitlab: func(rawurl string) (*Resource, error) {
+			path := rawurl[strings.Index(rawurl, ".com/")+5:]
+			return &Resource{Driver: DriverGitlab, Repo: path, Kind: ResourceKindRepository}, nil
+		},
+		DriverStash: func(rawurl string) (*Resource, error) {
+			if strings.HasSuffix(rawurl, "/pull-requests/1") {
+				return &Resource{Driver: DriverStash, Repo: "PRJ/repo", Kind: ResourceKindPullRequest, Number: 1}, nil
+			}
+			if strings.Contains(rawurl, "/projects/") {
+				return &Resource{Driver: DriverStash, Repo: "PRJ/repo", Kind: ResourceKindRepository}, nil
+			}
+			return nil, ErrUnknownURL
+		},
+	}
+	parsersMu.Unlock()
+	defer func() {
+		parsersMu.Lock()
+		parsers = saved
+		parsersMu.Unlock()
+	}()
+
+	tests := []struct {
+		url    string
+		driver Driver
+	}{
+		{"https://scm.acme.com/group/sub/repo", DriverGitlab},
+		{"https://scm.acme.com/projects/PRJ/repos/repo", DriverStash},
+		{"https://scm.acme.com/projects/PRJ/repos/repo/pull-requests/1", DriverStash},
+		// urls on a cloud provider host are only parsed
+		// by the provider driver.
+		{"https://gitlab.com/projects/PRJ/repos/repo/pull-requests/1", DriverGitlab},
+	}
+	for _, test := range tests {
+		res, err := ParseURL(test.url)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got, want := res.Driver, test.driver; got != want {
+			t.Errorf("Want driver %s for %s, got %s", want, test.url, got)
+		}
+	}
+}
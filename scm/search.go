@@ -0,0 +1,85 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// RepositorySearchOptions provides options for
+	// searching repositories.
+	RepositorySearchOptions struct {
+		Query    string
+		Topic    string
+		Language string
+		Org      string
+		Page     int
+		Size     int
+	}
+
+	// CodeSearchOptions provides options for searching
+	// repository code.
+	CodeSearchOptions struct {
+		Query string
+		Repo  string
+		Org   string
+		Path  string
+		Page  int
+		Size  int
+	}
+
+	// IssueSearchOptions provides options for searching
+	// issues and pull requests. The Since and Until
+	// fields filter results by the time of last update.
+	IssueSearchOptions struct {
+		Query       string
+		Repo        string
+		Org         string
+		Author      string
+		Labels      []string
+		PullRequest bool
+		Open        bool
+		Closed      bool
+		Since       time.Time
+		Until       time.Time
+		Page        int
+		Size        int
+	}
+
+	// CodeResult represents a file matching a code search.
+	CodeResult struct {
+		Repo      string
+		Path      string
+		Sha       string
+		Ref       string
+		Link      string
+		Fragments []Fragment
+	}
+
+	// Fragment represents a matching fragment of a file.
+	// The Line number is zero if the provider does not
+	// report the location of the fragment.
+	Fragment struct {
+		Line int
+		Text string
+	}
+
+	// SearchService provides access to search resources.
+	SearchService interface {
+		// Repositories returns a list of repositories
+		// matching the search options.
+		Repositories(context.Context, RepositorySearchOptions) ([]*Repository, *Response, error)
+
+		// Code returns a list of files matching the
+		// search options.
+		Code(context.Context, CodeSearchOptions) ([]*CodeResult, *Response, error)
+
+		// Issues returns a list of issues, or pull requests
+		// when PullRequest is set, matching the search options.
+		Issues(context.Context, IssueSearchOptions) ([]*Issue, *Response, error)
+	}
+)
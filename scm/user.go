@@ -12,14 +12,56 @@ import (
 type (
 	// User represents a user account.
 	User struct {
+		ID      string
 		Login   string
 		Name    string
 		Email   string
 		Avatar  string
+		Link    string
+		Type    UserType
+		Admin   bool
 		Created time.Time
 		Updated time.Time
 	}
 
+	// Email represents a user account email address.
+	Email struct {
+		Address  string
+		Primary  bool
+		Verified bool
+	}
+
+	// Key represents a user account public ssh key.
+	Key struct {
+		ID      int
+		Title   string
+		Key     string
+		Created time.Time
+	}
+
+	// KeyInput provides the input fields required for
+	// adding a public ssh key to the user account.
+	KeyInput struct {
+		Title string
+		Key   string
+	}
+
+	// GPGKey represents a user account public gpg key.
+	GPGKey struct {
+		ID      int
+		KeyID   string
+		Key     string
+		Emails  []string
+		Created time.Time
+		Expires time.Time
+	}
+
+	// GPGKeyInput provides the input fields required for
+	// adding an armored public gpg key to the user account.
+	GPGKeyInput struct {
+		Key string
+	}
+
 	// UserService provides access to user account resources.
 	UserService interface {
 		// Find returns the authenticated user.
@@ -30,5 +72,33 @@ type (
 
 		// FindLogin returns the user account by username.
 		FindLogin(context.Context, string) (*User, *Response, error)
+
+		// FindByEmail returns the user account by email
+		// address, for example to resolve a commit signature.
+		FindByEmail(context.Context, string) (*User, *Response, error)
+
+		// ListEmails returns the authenticated user email
+		// addresses.
+		ListEmails(context.Context, ListOptions) ([]*Email, *Response, error)
+
+		// ListKeys returns the authenticated user public ssh
+		// keys.
+		ListKeys(context.Context, ListOptions) ([]*Key, *Response, error)
+
+		// CreateKey adds a public ssh key to the authenticated
+		// user account.
+		CreateKey(context.Context, *KeyInput) (*Key, *Response, error)
+
+		// ListGPGKeys returns the authenticated user public gpg
+		// keys.
+		ListGPGKeys(context.Context, ListOptions) ([]*GPGKey, *Response, error)
+
+		// CreateGPGKey adds a public gpg key to the
+		// authenticated user account.
+		CreateGPGKey(context.Context, *GPGKeyInput) (*GPGKey, *Response, error)
+
+		// ListRepositories returns the public repositories of
+		// the user account by username.
+		ListRepositories(context.Context, string, ListOptions) ([]*Repository, *Response, error)
 	}
 )
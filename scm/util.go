@@ -4,15 +4,7 @@
 
 package scm
 
-import (
-	"regexp"
-	"strconv"
-	"strings"
-)
-
-// regular expression to extract the pull request number
-// from the git ref (e.g. refs/pulls/{d}/head)
-var re = regexp.MustCompile("\\d+")
+import "strings"
 
 // Split splits the full repository name into segments.
 func Split(s string) (owner, name string) {
@@ -27,6 +19,16 @@ func Split(s string) (owner, name string) {
 	return
 }
 
+// SplitNamespace splits the full repository name into the
+// namespace and name at the last separator, for providers
+// that support nested namespaces (e.g. GitLab subgroups).
+func SplitNamespace(s string) (namespace, name string) {
+	if i := strings.LastIndex(s, "/"); i != -1 {
+		return s[:i], s[i+1:]
+	}
+	return "", s
+}
+
 // Join joins the repository owner and name segments to
 // create a fully qualified repository name.
 func Join(owner, name string) string {
@@ -51,11 +53,10 @@ func ExpandRef(name, prefix string) string {
 }
 
 // ExtractPullRequest returns name extraced pull request
-// number from the reference path.
+// number from the reference path, or zero if the reference
+// path does not point to a pull request.
 func ExtractPullRequest(ref string) int {
-	s := re.FindString(ref)
-	d, _ := strconv.Atoi(s)
-	return d
+	return ParseRef(ref).Number
 }
 
 // IsBranch returns true if the reference path points to
@@ -73,8 +74,5 @@ func IsTag(ref string) bool {
 // IsPullRequest returns true if the reference path points
 // to a pull request object.
 func IsPullRequest(ref string) bool {
-	return strings.HasPrefix(ref, "refs/pull/") ||
-		strings.HasPrefix(ref, "refs/pull-request/") ||
-		strings.HasPrefix(ref, "refs/merge-requests/")
+	return ParseRef(ref).IsPullRequest()
 }
-
@@ -26,6 +26,26 @@ func TestSplit(t *testing.T) {
 	}
 }
 
+func TestSplitNamespace(t *testing.T) {
+	tests := []struct {
+		value, namespace, name string
+	}{
+		{"octocat/hello-world", "octocat", "hello-world"},
+		{"group/subgroup/project", "group/subgroup", "project"},
+		{"hello-world", "", "hello-world"},
+		{value: ""}, // empty value returns nothing
+	}
+	for _, test := range tests {
+		namespace, name := SplitNamespace(test.value)
+		if got, want := namespace, test.namespace; got != want {
+			t.Errorf("Got repository namespace %s, want %s", got, want)
+		}
+		if got, want := name, test.name; got != want {
+			t.Errorf("Got repository name %s, want %s", got, want)
+		}
+	}
+}
+
 func TestJoin(t *testing.T) {
 	got, want := Join("octocat", "hello-world"), "octocat/hello-world"
 	if got != want {
@@ -153,6 +173,10 @@ func TestIsPullRequest(t *testing.T) {
 			name: "refs/heads/master",
 			tag:  false,
 		},
+		{
+			name: "refs/heads/pull/12/head",
+			tag:  false,
+		},
 	}
 	for _, test := range tests {
 		if got, want := IsPullRequest(test.name), test.tag; got != want {
@@ -182,10 +206,18 @@ func TestExtractPullRequest(t *testing.T) {
 			name:   "refs/merge-requests/12/head",
 			number: 12,
 		},
+		{
+			name:   "refs/pull-requests/12/from",
+			number: 12,
+		},
 		{
 			name:   "refs/heads/master",
 			number: 0,
 		},
+		{
+			name:   "refs/heads/release-2/fix",
+			number: 0,
+		},
 	}
 	for _, test := range tests {
 		if got, want := ExtractPullRequest(test.name), test.number; got != want {